@@ -0,0 +1,103 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// AURChecker checks package name availability on the Arch User Repository.
+type AURChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewAURChecker(client *http.Client, baseURL string) *AURChecker {
+	return &AURChecker{client: client, baseURL: baseURL}
+}
+
+func (c *AURChecker) Name() string        { return "aur" }
+func (c *AURChecker) DisplayName() string { return "AUR" }
+
+type aurPackage struct {
+	Name       string  `json:"Name"`
+	Maintainer *string `json:"Maintainer"`
+	OutOfDate  *int64  `json:"OutOfDate"`
+}
+
+func (c *AURChecker) Check(ctx context.Context, name string) Result {
+	u := c.baseURL + "/rpc/v5/info?" + url.Values{"arg[]": {name}}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusTooManyRequests:
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Unknown,
+			Err:      fmt.Errorf("rate limited"),
+		}
+	default:
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Unknown,
+			Err:      fmt.Errorf("unexpected status: %d", resp.StatusCode),
+		}
+	}
+
+	var data struct {
+		Type    string       `json:"type"`
+		Error   string       `json:"error"`
+		Results []aurPackage `json:"results"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&data); err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	if data.Type == "error" {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: fmt.Errorf("aur: %s", data.Error)}
+	}
+
+	for _, pkg := range data.Results {
+		if pkg.Name != name {
+			continue
+		}
+		outOfDate := ""
+		if pkg.OutOfDate != nil {
+			outOfDate = "flagged out-of-date since " + time.Unix(*pkg.OutOfDate, 0).UTC().Format("2006-01-02")
+		}
+		// Orphaned packages can be adopted by anyone from the AUR web interface.
+		if pkg.Maintainer == nil || *pkg.Maintainer == "" {
+			return Result{
+				Registry: c.DisplayName(),
+				Name:     name,
+				Status:   Adoptable,
+				Detail:   joinNonEmpty(", ", "orphaned, can be adopted", outOfDate),
+			}
+		}
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Taken,
+			Detail:   joinNonEmpty(", ", "maintainer: "+*pkg.Maintainer, outOfDate),
+		}
+	}
+
+	return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+}
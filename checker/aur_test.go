@@ -0,0 +1,139 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAURChecker_Taken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"resultcount":1,"type":"multiinfo","version":5,"results":[` +
+			`{"Name":"yay","Maintainer":"jguer","OutOfDate":null}]}`))
+	}))
+	defer srv.Close()
+
+	c := NewAURChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "yay")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "maintainer: jguer" {
+		t.Errorf("expected detail 'maintainer: jguer', got %q", result.Detail)
+	}
+}
+
+func TestAURChecker_TakenOutOfDate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"resultcount":1,"type":"multiinfo","version":5,"results":[` +
+			`{"Name":"stale","Maintainer":"someone","OutOfDate":1704067200}]}`))
+	}))
+	defer srv.Close()
+
+	c := NewAURChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "stale")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	want := "maintainer: someone, flagged out-of-date since 2024-01-01"
+	if result.Detail != want {
+		t.Errorf("expected detail %q, got %q", want, result.Detail)
+	}
+}
+
+func TestAURChecker_Orphaned(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"resultcount":1,"type":"multiinfo","version":5,"results":[` +
+			`{"Name":"abandoned","Maintainer":null,"OutOfDate":1704067200}]}`))
+	}))
+	defer srv.Close()
+
+	c := NewAURChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "abandoned")
+
+	if result.Status != Adoptable {
+		t.Errorf("expected Adoptable, got %v", result.Status)
+	}
+	want := "orphaned, can be adopted, flagged out-of-date since 2024-01-01"
+	if result.Detail != want {
+		t.Errorf("expected detail %q, got %q", want, result.Detail)
+	}
+}
+
+func TestAURChecker_Available(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"resultcount":0,"type":"multiinfo","version":5,"results":[]}`))
+	}))
+	defer srv.Close()
+
+	c := NewAURChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+}
+
+func TestAURChecker_RPCError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"resultcount":0,"type":"error","version":5,"results":[],"error":"Too many package results."}`))
+	}))
+	defer srv.Close()
+
+	c := NewAURChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestAURChecker_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	c := NewAURChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+}
+
+func TestAURChecker_Request(t *testing.T) {
+	var receivedPath, receivedArg string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedPath = r.URL.Path
+		receivedArg = r.URL.Query().Get("arg[]")
+		_, _ = w.Write([]byte(`{"resultcount":0,"type":"multiinfo","version":5,"results":[]}`))
+	}))
+	defer srv.Close()
+
+	c := NewAURChecker(srv.Client(), srv.URL)
+	c.Check(context.Background(), "myproject")
+
+	if receivedPath != "/rpc/v5/info" {
+		t.Errorf("expected path '/rpc/v5/info', got %q", receivedPath)
+	}
+	if receivedArg != "myproject" {
+		t.Errorf("expected arg[]=myproject, got %q", receivedArg)
+	}
+}
+
+func TestAURChecker_Name(t *testing.T) {
+	c := NewAURChecker(http.DefaultClient, "")
+	if c.Name() != "aur" {
+		t.Errorf("expected name 'aur', got %q", c.Name())
+	}
+	if c.DisplayName() != "AUR" {
+		t.Errorf("expected display name 'AUR', got %q", c.DisplayName())
+	}
+}
@@ -0,0 +1,56 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// BitbucketChecker checks workspace slug availability on Bitbucket Cloud.
+type BitbucketChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewBitbucketChecker(client *http.Client, baseURL string) *BitbucketChecker {
+	return &BitbucketChecker{client: client, baseURL: baseURL}
+}
+
+func (c *BitbucketChecker) Name() string        { return "bitbucket" }
+func (c *BitbucketChecker) DisplayName() string { return "Bitbucket" }
+
+func (c *BitbucketChecker) Check(ctx context.Context, name string) Result {
+	// Workspace slugs are lowercase.
+	u := c.baseURL + "/2.0/workspaces/" + url.PathEscape(strings.ToLower(name))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return Result{Registry: c.DisplayName(), Name: name, Status: Taken}
+	case http.StatusNotFound:
+		return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+	case http.StatusTooManyRequests:
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: fmt.Errorf("rate limited")}
+	default:
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Unknown,
+			Err:      fmt.Errorf("unexpected status: %d", resp.StatusCode),
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBitbucketChecker_Taken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"type":"workspace","slug":"atlassian","name":"Atlassian"}`))
+	}))
+	defer srv.Close()
+
+	c := NewBitbucketChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "atlassian")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+}
+
+func TestBitbucketChecker_Available(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewBitbucketChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+}
+
+func TestBitbucketChecker_RateLimited(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	c := NewBitbucketChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil || result.Err.Error() != "rate limited" {
+		t.Errorf("expected 'rate limited' error, got %v", result.Err)
+	}
+}
+
+func TestBitbucketChecker_URLPath(t *testing.T) {
+	var receivedPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedPath = r.URL.Path
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewBitbucketChecker(srv.Client(), srv.URL)
+	c.Check(context.Background(), "MyProject")
+
+	if receivedPath != "/2.0/workspaces/myproject" {
+		t.Errorf("expected path '/2.0/workspaces/myproject', got %q", receivedPath)
+	}
+}
+
+func TestBitbucketChecker_Name(t *testing.T) {
+	c := NewBitbucketChecker(http.DefaultClient, "")
+	if c.Name() != "bitbucket" {
+		t.Errorf("expected name 'bitbucket', got %q", c.Name())
+	}
+	if c.DisplayName() != "Bitbucket" {
+		t.Errorf("expected display name 'Bitbucket', got %q", c.DisplayName())
+	}
+}
@@ -1,6 +1,9 @@
 package checker
 
-import "context"
+import (
+	"context"
+	"strings"
+)
 
 // Status represents the result of a name availability check.
 type Status int
@@ -9,6 +12,15 @@ const (
 	Available Status = iota
 	Taken
 	Unknown
+	// Reserved means nobody has published the name, but the registry would
+	// still refuse it (e.g. it falls under someone else's reserved prefix).
+	Reserved
+	// Invalid means the name breaks the registry's naming rules and could
+	// never be published there.
+	Invalid
+	// Adoptable means the name is held by an abandoned entry that anyone can
+	// take over (e.g. an orphaned AUR package).
+	Adoptable
 )
 
 func (s Status) String() string {
@@ -17,11 +29,23 @@ func (s Status) String() string {
 		return "available"
 	case Taken:
 		return "taken"
+	case Reserved:
+		return "reserved"
+	case Invalid:
+		return "invalid"
+	case Adoptable:
+		return "adoptable"
 	default:
 		return "unknown"
 	}
 }
 
+// Field is a labelled fact about an existing name (e.g. "type: Organization").
+type Field struct {
+	Key   string
+	Value string
+}
+
 // Result holds the outcome of a single registry check.
 type Result struct {
 	Registry string
@@ -29,6 +53,7 @@ type Result struct {
 	Status   Status
 	Err      error
 	Detail   string
+	Fields   []Field
 }
 
 // Checker is the interface every registry checker must implement.
@@ -42,3 +67,38 @@ type Checker interface {
 	// Check tests whether the given name is available on this registry.
 	Check(ctx context.Context, name string) Result
 }
+
+// NameTransformer is implemented by checkers whose registry spells names
+// differently from the user's input (e.g. CPAN's "My-Tool" for "my-tool").
+// The runner passes the transformed name to Check.
+type NameTransformer interface {
+	TransformName(name string) string
+}
+
+// joinErrors combines the non-nil errs like errors.Join, but with the messages
+// on one line separated by "; ", since output prints Err on the result's row.
+// It returns nil if every error is nil.
+func joinErrors(errs ...error) error {
+	var joined multiError
+	for _, err := range errs {
+		if err != nil {
+			joined = append(joined, err)
+		}
+	}
+	if len(joined) == 0 {
+		return nil
+	}
+	return joined
+}
+
+type multiError []error
+
+func (e multiError) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e multiError) Unwrap() []error { return e }
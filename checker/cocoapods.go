@@ -0,0 +1,78 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// CocoaPodsChecker checks pod name availability on the CocoaPods trunk.
+type CocoaPodsChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewCocoaPodsChecker(client *http.Client, baseURL string) *CocoaPodsChecker {
+	return &CocoaPodsChecker{client: client, baseURL: baseURL}
+}
+
+func (c *CocoaPodsChecker) Name() string        { return "cocoapods" }
+func (c *CocoaPodsChecker) DisplayName() string { return "CocoaPods" }
+
+func (c *CocoaPodsChecker) Check(ctx context.Context, name string) Result {
+	u := c.baseURL + "/api/v1/pods/" + url.PathEscape(name)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		detail := parseCocoaPodsOwners(resp.Body)
+		return Result{Registry: c.DisplayName(), Name: name, Status: Taken, Detail: detail}
+	case http.StatusNotFound:
+		return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+	default:
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Unknown,
+			Err:      fmt.Errorf("unexpected status: %d", resp.StatusCode),
+		}
+	}
+}
+
+func parseCocoaPodsOwners(body io.Reader) string {
+	var data struct {
+		Owners []struct {
+			Name string `json:"name"`
+		} `json:"owners"`
+	}
+	if err := json.NewDecoder(io.LimitReader(body, 1<<20)).Decode(&data); err != nil {
+		return ""
+	}
+
+	var owners []string
+	for _, o := range data.Owners {
+		if o.Name != "" {
+			owners = append(owners, o.Name)
+		}
+	}
+	if len(owners) == 0 {
+		return ""
+	}
+	return "owners: " + strings.Join(owners, ", ")
+}
@@ -0,0 +1,103 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCocoaPodsChecker_Taken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"versions":[{"name":"5.9.0"}],"owners":[` +
+			`{"name":"Alamofire Software Foundation","email":"info@alamofire.org"},` +
+			`{"name":"Jon Shier","email":"jon@example.com"}]}`))
+	}))
+	defer srv.Close()
+
+	c := NewCocoaPodsChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "Alamofire")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	want := "owners: Alamofire Software Foundation, Jon Shier"
+	if result.Detail != want {
+		t.Errorf("expected detail %q, got %q", want, result.Detail)
+	}
+}
+
+func TestCocoaPodsChecker_Available(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewCocoaPodsChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+}
+
+func TestCocoaPodsChecker_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewCocoaPodsChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestCocoaPodsChecker_Timeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(5 * time.Second)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	c := NewCocoaPodsChecker(srv.Client(), srv.URL)
+	result := c.Check(ctx, "anything")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown on timeout, got %v", result.Status)
+	}
+}
+
+func TestCocoaPodsChecker_URLPath(t *testing.T) {
+	var receivedPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedPath = r.URL.Path
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewCocoaPodsChecker(srv.Client(), srv.URL)
+	c.Check(context.Background(), "myproject")
+
+	if receivedPath != "/api/v1/pods/myproject" {
+		t.Errorf("expected path '/api/v1/pods/myproject', got %q", receivedPath)
+	}
+}
+
+func TestCocoaPodsChecker_Name(t *testing.T) {
+	c := NewCocoaPodsChecker(http.DefaultClient, "")
+	if c.Name() != "cocoapods" {
+		t.Errorf("expected name 'cocoapods', got %q", c.Name())
+	}
+	if c.DisplayName() != "CocoaPods" {
+		t.Errorf("expected display name 'CocoaPods', got %q", c.DisplayName())
+	}
+}
@@ -0,0 +1,137 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// CPANChecker checks Perl distribution and module name availability on MetaCPAN.
+type CPANChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewCPANChecker(client *http.Client, baseURL string) *CPANChecker {
+	return &CPANChecker{client: client, baseURL: baseURL}
+}
+
+func (c *CPANChecker) Name() string        { return "cpan" }
+func (c *CPANChecker) DisplayName() string { return "CPAN" }
+
+// TransformName turns a project name into a CPAN distribution name by
+// capitalising each hyphen-, underscore- or "::"-separated part, so
+// "my-tool" becomes "My-Tool".
+func (c *CPANChecker) TransformName(name string) string {
+	parts := strings.FieldsFunc(strings.ReplaceAll(name, "::", "-"), func(r rune) bool {
+		return r == '-' || r == '_'
+	})
+	for i, p := range parts {
+		r, size := utf8.DecodeRuneInString(p)
+		parts[i] = string(unicode.ToUpper(r)) + p[size:]
+	}
+	return strings.Join(parts, "-")
+}
+
+// Check expects a distribution name as produced by TransformName and looks up
+// both the distribution ("My-Tool") and its main module ("My::Tool").
+func (c *CPANChecker) Check(ctx context.Context, name string) Result {
+	module := strings.ReplaceAll(name, "-", "::")
+
+	var (
+		distFound, moduleFound bool
+		author, distribution   string
+		distErr, moduleErr     error
+		wg                     sync.WaitGroup
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		var data struct {
+			Author string `json:"author"`
+		}
+		distFound, distErr = c.lookup(ctx, "/v1/release/"+url.PathEscape(name), &data)
+		author = data.Author
+	}()
+	go func() {
+		defer wg.Done()
+		var data struct {
+			Distribution string `json:"distribution"`
+		}
+		moduleFound, moduleErr = c.lookup(ctx, "/v1/module/"+url.PathEscape(module), &data)
+		distribution = data.Distribution
+	}()
+	wg.Wait()
+
+	var found []string
+	if distFound {
+		line := "distribution " + name
+		if author != "" {
+			line += " (by " + author + ")"
+		}
+		found = append(found, line)
+	}
+	if moduleFound {
+		line := "module " + module
+		if distribution != "" {
+			line += " (in " + distribution + ")"
+		}
+		found = append(found, line)
+	}
+
+	if len(found) > 0 {
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Taken,
+			Detail:   strings.Join(found, "\n"),
+		}
+	}
+
+	if distErr != nil {
+		distErr = fmt.Errorf("distribution: %w", distErr)
+	}
+	if moduleErr != nil {
+		moduleErr = fmt.Errorf("module: %w", moduleErr)
+	}
+	if err := joinErrors(distErr, moduleErr); err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+
+	return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+}
+
+// lookup fetches path and decodes it into v, returning (found, error).
+func (c *CPANChecker) lookup(ctx context.Context, path string, v any) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(v); err != nil {
+			return false, err
+		}
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,155 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestCPANChecker_TransformName(t *testing.T) {
+	tests := map[string]string{
+		"my-tool":  "My-Tool",
+		"my_tool":  "My-Tool",
+		"Foo::Bar": "Foo-Bar",
+		"moose":    "Moose",
+		"myTool":   "MyTool",
+		"a--b":     "A-B",
+	}
+	c := NewCPANChecker(http.DefaultClient, "")
+	for in, want := range tests {
+		if got := c.TransformName(in); got != want {
+			t.Errorf("TransformName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestCPANChecker_TakenDistributionAndModule(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/release/Moose-Util":
+			_, _ = w.Write([]byte(`{"distribution":"Moose-Util","author":"ETHER"}`))
+		case "/v1/module/Moose::Util":
+			_, _ = w.Write([]byte(`{"distribution":"Moose","author":"ETHER"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewCPANChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "Moose-Util")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	want := "distribution Moose-Util (by ETHER)\nmodule Moose::Util (in Moose)"
+	if result.Detail != want {
+		t.Errorf("expected detail %q, got %q", want, result.Detail)
+	}
+}
+
+func TestCPANChecker_TakenModuleOnly(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/module/My::Tool" {
+			_, _ = w.Write([]byte(`{"distribution":"App-MyTool"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewCPANChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "My-Tool")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "module My::Tool (in App-MyTool)" {
+		t.Errorf("expected module detail, got %q", result.Detail)
+	}
+}
+
+func TestCPANChecker_Available(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewCPANChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "Xyzzy-Nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+}
+
+func TestCPANChecker_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewCPANChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "Test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestCPANChecker_DistributionErrorIsUnknown(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/release/My-Tool" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewCPANChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "My-Tool")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestCPANChecker_URLPaths(t *testing.T) {
+	var mu sync.Mutex
+	paths := map[string]bool{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths[r.URL.Path] = true
+		mu.Unlock()
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewCPANChecker(srv.Client(), srv.URL)
+	c.Check(context.Background(), c.TransformName("my-tool"))
+
+	for _, want := range []string{"/v1/release/My-Tool", "/v1/module/My::Tool"} {
+		if !paths[want] {
+			t.Errorf("expected request for %q, got %v", want, paths)
+		}
+	}
+}
+
+func TestCPANChecker_Name(t *testing.T) {
+	c := NewCPANChecker(http.DefaultClient, "")
+	if c.Name() != "cpan" {
+		t.Errorf("expected name 'cpan', got %q", c.Name())
+	}
+	if c.DisplayName() != "CPAN" {
+		t.Errorf("expected display name 'CPAN', got %q", c.DisplayName())
+	}
+}
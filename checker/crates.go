@@ -2,9 +2,14 @@ package checker
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"slices"
+	"strings"
+	"sync"
 )
 
 // CratesChecker checks crate name availability on crates.io.
@@ -20,32 +25,118 @@ func NewCratesChecker(client *http.Client, baseURL string) *CratesChecker {
 func (c *CratesChecker) Name() string        { return "crates" }
 func (c *CratesChecker) DisplayName() string { return "crates.io" }
 
+// cratesReservedNames are refused by crates.io on publish because they name
+// crates shipped with the Rust toolchain. Keys are in canonical form (see
+// canonicalCrateName).
+var cratesReservedNames = map[string]bool{
+	"alloc": true, "arena": true, "ast": true, "builtins": true,
+	"collections": true, "compiler_builtins": true, "compiler_rt": true,
+	"compiletest": true, "core": true, "coretest": true, "debug": true,
+	"driver": true, "flate": true, "fmt_macros": true, "grammar": true,
+	"graphviz": true, "macro": true, "macros": true, "proc_macro": true,
+	"rbml": true, "rust_installer": true, "rustbook": true, "rustc": true,
+	"rustc_back": true, "rustc_borrowck": true, "rustc_driver": true,
+	"rustc_llvm": true, "rustc_resolve": true, "rustc_trans": true,
+	"rustc_typeck": true, "rustdoc": true, "rustllvm": true, "rustuv": true,
+	"serialize": true, "std": true, "syntax": true, "test": true,
+	"unicode": true,
+}
+
+// windowsDeviceNames are refused too, since a crate directory with one of
+// these names can't be created on Windows.
+var windowsDeviceNames = map[string]bool{
+	"nul": true, "con": true, "prn": true, "aux": true,
+	"com1": true, "com2": true, "com3": true, "com4": true, "com5": true,
+	"com6": true, "com7": true, "com8": true, "com9": true,
+	"lpt1": true, "lpt2": true, "lpt3": true, "lpt4": true, "lpt5": true,
+	"lpt6": true, "lpt7": true, "lpt8": true, "lpt9": true,
+}
+
+// canonicalCrateName folds case and treats '-' and '_' as the same
+// character, matching how crates.io detects name collisions.
+func canonicalCrateName(name string) string {
+	return strings.ReplaceAll(strings.ToLower(name), "-", "_")
+}
+
 func (c *CratesChecker) Check(ctx context.Context, name string) Result {
+	canonical := canonicalCrateName(name)
+	if cratesReservedNames[canonical] {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Reserved, Detail: "reserved by crates.io"}
+	}
+	if windowsDeviceNames[canonical] {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Reserved, Detail: "Windows device name"}
+	}
+
+	// The API only matches the spelling asked for, so probe the hyphen and
+	// underscore forms as well.
+	var variants []string
+	for _, v := range []string{name, strings.ReplaceAll(name, "_", "-"), strings.ReplaceAll(name, "-", "_")} {
+		if !slices.Contains(variants, v) {
+			variants = append(variants, v)
+		}
+	}
+
+	found := make([]string, len(variants))
+	errs := make([]error, len(variants))
+	var wg sync.WaitGroup
+	for i, v := range variants {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			found[i], errs[i] = c.lookup(ctx, v)
+		}()
+	}
+	wg.Wait()
+
+	for _, existing := range found {
+		if existing == "" {
+			continue
+		}
+		var detail string
+		if existing != name {
+			detail = "published as " + existing
+		}
+		return Result{Registry: c.DisplayName(), Name: name, Status: Taken, Detail: detail}
+	}
+
+	if err := joinErrors(errs...); err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+
+	return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+}
+
+// lookup returns the published name of the crate called name, or "" if there
+// is none.
+func (c *CratesChecker) lookup(ctx context.Context, name string) (string, error) {
 	u := c.baseURL + "/api/v1/crates/" + url.PathEscape(name)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+		return "", err
 	}
 	req.Header.Set("User-Agent", "nsprobe/1.0")
 
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+		return "", err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	switch resp.StatusCode {
 	case http.StatusOK:
-		return Result{Registry: c.DisplayName(), Name: name, Status: Taken}
+		var data struct {
+			Crate struct {
+				Name string `json:"name"`
+			} `json:"crate"`
+		}
+		if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&data); err != nil || data.Crate.Name == "" {
+			return name, nil
+		}
+		return data.Crate.Name, nil
 	case http.StatusNotFound:
-		return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+		return "", nil
 	default:
-		return Result{
-			Registry: c.DisplayName(),
-			Name:     name,
-			Status:   Unknown,
-			Err:      fmt.Errorf("unexpected status: %d", resp.StatusCode),
-		}
+		return "", fmt.Errorf("unexpected status: %d", resp.StatusCode)
 	}
 }
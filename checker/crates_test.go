@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 )
 
@@ -46,7 +47,7 @@ func TestCratesChecker_ServerError(t *testing.T) {
 	defer srv.Close()
 
 	c := NewCratesChecker(srv.Client(), srv.URL)
-	result := c.Check(context.Background(), "test")
+	result := c.Check(context.Background(), "mytool")
 
 	if result.Status != Unknown {
 		t.Errorf("expected Unknown, got %v", result.Status)
@@ -56,10 +57,13 @@ func TestCratesChecker_ServerError(t *testing.T) {
 	}
 }
 
-func TestCratesChecker_URLPath(t *testing.T) {
-	var receivedPath string
+func TestCratesChecker_URLPaths(t *testing.T) {
+	var mu sync.Mutex
+	paths := make(map[string]bool)
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		receivedPath = r.URL.Path
+		mu.Lock()
+		paths[r.URL.Path] = true
+		mu.Unlock()
 		w.WriteHeader(http.StatusNotFound)
 	}))
 	defer srv.Close()
@@ -67,8 +71,67 @@ func TestCratesChecker_URLPath(t *testing.T) {
 	c := NewCratesChecker(srv.Client(), srv.URL)
 	c.Check(context.Background(), "my-crate")
 
-	if receivedPath != "/api/v1/crates/my-crate" {
-		t.Errorf("expected path '/api/v1/crates/my-crate', got %q", receivedPath)
+	for _, want := range []string{"/api/v1/crates/my-crate", "/api/v1/crates/my_crate"} {
+		if !paths[want] {
+			t.Errorf("expected path %q in requests, got %v", want, paths)
+		}
+	}
+	if len(paths) != 2 {
+		t.Errorf("expected 2 requests, got %v", paths)
+	}
+}
+
+func TestCratesChecker_SeparatorEquivalence(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/crates/foo-bar" {
+			_, _ = w.Write([]byte(`{"crate":{"name":"foo-bar"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewCratesChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "foo_bar")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	if result.Detail != "published as foo-bar" {
+		t.Errorf("expected detail 'published as foo-bar', got %q", result.Detail)
+	}
+}
+
+func TestCratesChecker_Reserved(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewCratesChecker(srv.Client(), srv.URL)
+	tests := []struct {
+		name   string
+		detail string
+	}{
+		{"std", "reserved by crates.io"},
+		{"proc-macro", "reserved by crates.io"},
+		{"Core", "reserved by crates.io"},
+		{"nul", "Windows device name"},
+		{"COM1", "Windows device name"},
+	}
+	for _, tt := range tests {
+		result := c.Check(context.Background(), tt.name)
+		if result.Status != Reserved {
+			t.Errorf("%s: expected Reserved, got %v", tt.name, result.Status)
+		}
+		if result.Detail != tt.detail {
+			t.Errorf("%s: expected detail %q, got %q", tt.name, tt.detail, result.Detail)
+		}
+	}
+	if requests != 0 {
+		t.Errorf("expected no requests for reserved names, got %d", requests)
 	}
 }
 
@@ -81,7 +144,7 @@ func TestCratesChecker_UserAgent(t *testing.T) {
 	defer srv.Close()
 
 	c := NewCratesChecker(srv.Client(), srv.URL)
-	c.Check(context.Background(), "test")
+	c.Check(context.Background(), "mytool")
 
 	if receivedUA != "nsprobe/1.0" {
 		t.Errorf("expected User-Agent 'nsprobe/1.0', got %q", receivedUA)
@@ -0,0 +1,142 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// distroFamilies maps Repology repository name prefixes to the distributions
+// reported individually, in display order. Other repositories are counted.
+var distroFamilies = []struct {
+	prefix string
+	label  string
+}{
+	{"debian", "Debian"},
+	{"ubuntu", "Ubuntu"},
+	{"arch", "Arch"},
+	{"aur", "AUR"},
+	{"fedora", "Fedora"},
+	{"centos", "CentOS"},
+	{"epel", "EPEL"},
+	{"opensuse", "openSUSE"},
+	{"alpine", "Alpine"},
+	{"nix", "nixpkgs"},
+	{"gentoo", "Gentoo"},
+	{"void", "Void"},
+	{"freebsd", "FreeBSD"},
+}
+
+// DistroChecker checks whether Linux (and BSD) distributions already ship a
+// package with the given name, using the Repology aggregator.
+type DistroChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewDistroChecker(client *http.Client, baseURL string) *DistroChecker {
+	return &DistroChecker{client: client, baseURL: baseURL}
+}
+
+func (c *DistroChecker) Name() string        { return "distro" }
+func (c *DistroChecker) DisplayName() string { return "Linux distros" }
+
+func (c *DistroChecker) Check(ctx context.Context, name string) Result {
+	// Repology project names are lowercase.
+	u := c.baseURL + "/api/v1/project/" + url.PathEscape(strings.ToLower(name))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		var pkgs []struct {
+			Repo string `json:"repo"`
+		}
+		if err := json.NewDecoder(io.LimitReader(resp.Body, 16<<20)).Decode(&pkgs); err != nil {
+			return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+		}
+		// An unknown project is an empty list rather than a 404.
+		if len(pkgs) == 0 {
+			return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+		}
+		repos := make([]string, len(pkgs))
+		for i, p := range pkgs {
+			repos[i] = p.Repo
+		}
+		return Result{Registry: c.DisplayName(), Name: name, Status: Taken, Detail: groupDistroRepos(repos)}
+	case http.StatusNotFound:
+		return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+	case http.StatusTooManyRequests:
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Unknown,
+			Err:      fmt.Errorf("rate limited"),
+		}
+	default:
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Unknown,
+			Err:      fmt.Errorf("unexpected status: %d", resp.StatusCode),
+		}
+	}
+}
+
+// groupDistroRepos renders one line per known distribution listing its
+// repositories (e.g. "Debian: debian_12, debian_13"), followed by a count of
+// any other repositories.
+func groupDistroRepos(repos []string) string {
+	byFamily := make(map[string][]string)
+	others := make(map[string]bool)
+	for _, repo := range repos {
+		family, _, _ := strings.Cut(repo, "_")
+		known := false
+		for _, f := range distroFamilies {
+			if family == f.prefix {
+				if !slices.Contains(byFamily[f.label], repo) {
+					byFamily[f.label] = append(byFamily[f.label], repo)
+				}
+				known = true
+				break
+			}
+		}
+		if !known {
+			others[repo] = true
+		}
+	}
+
+	var lines []string
+	for _, f := range distroFamilies {
+		if r := byFamily[f.label]; len(r) > 0 {
+			slices.Sort(r)
+			lines = append(lines, f.label+": "+strings.Join(r, ", "))
+		}
+	}
+	if n := len(others); n > 0 {
+		line := fmt.Sprintf("%d other repositories", n)
+		if n == 1 {
+			line = "1 other repository"
+		}
+		if len(lines) > 0 {
+			line = "…and " + line
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
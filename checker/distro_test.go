@@ -0,0 +1,129 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDistroChecker_Taken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"repo":"debian_13","binname":"ripgrep","version":"14.1.0"},
+			{"repo":"arch","binname":"ripgrep","version":"14.1.1"},
+			{"repo":"debian_12","binname":"ripgrep","version":"13.0.0"},
+			{"repo":"debian_12","binname":"ripgrep-doc","version":"13.0.0"},
+			{"repo":"nix_unstable","binname":"ripgrep","version":"14.1.1"},
+			{"repo":"alpine_edge","binname":"ripgrep","version":"14.1.1"},
+			{"repo":"fedora_40","binname":"ripgrep","version":"14.1.0"},
+			{"repo":"scoop","binname":"ripgrep","version":"14.1.1"},
+			{"repo":"chocolatey","binname":"ripgrep","version":"14.1.1"}
+		]`))
+	}))
+	defer srv.Close()
+
+	c := NewDistroChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "ripgrep")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	want := "Debian: debian_12, debian_13\n" +
+		"Arch: arch\n" +
+		"Fedora: fedora_40\n" +
+		"Alpine: alpine_edge\n" +
+		"nixpkgs: nix_unstable\n" +
+		"…and 2 other repositories"
+	if result.Detail != want {
+		t.Errorf("expected detail:\n%s\ngot:\n%s", want, result.Detail)
+	}
+}
+
+func TestDistroChecker_TakenOnlyOtherRepos(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"repo":"scoop"}]`))
+	}))
+	defer srv.Close()
+
+	c := NewDistroChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "tool")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "1 other repository" {
+		t.Errorf("expected detail '1 other repository', got %q", result.Detail)
+	}
+}
+
+func TestDistroChecker_AvailableEmptyList(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	c := NewDistroChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+}
+
+func TestDistroChecker_RateLimited(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	c := NewDistroChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestDistroChecker_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewDistroChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+}
+
+func TestDistroChecker_URLPath(t *testing.T) {
+	var receivedPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedPath = r.URL.Path
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	c := NewDistroChecker(srv.Client(), srv.URL)
+	c.Check(context.Background(), "MyProject")
+
+	if receivedPath != "/api/v1/project/myproject" {
+		t.Errorf("expected path '/api/v1/project/myproject', got %q", receivedPath)
+	}
+}
+
+func TestDistroChecker_Name(t *testing.T) {
+	c := NewDistroChecker(http.DefaultClient, "")
+	if c.Name() != "distro" {
+		t.Errorf("expected name 'distro', got %q", c.Name())
+	}
+	if c.DisplayName() != "Linux distros" {
+		t.Errorf("expected display name 'Linux distros', got %q", c.DisplayName())
+	}
+}
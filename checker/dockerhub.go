@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
 )
 
-// DockerHubChecker checks namespace availability on Docker Hub.
+// DockerHubChecker checks Docker Hub for official images, organizations and
+// user namespaces with the given name.
 type DockerHubChecker struct {
 	client  *http.Client
 	baseURL string
@@ -20,39 +23,82 @@ func NewDockerHubChecker(client *http.Client, baseURL string) *DockerHubChecker
 func (c *DockerHubChecker) Name() string        { return "dockerhub" }
 func (c *DockerHubChecker) DisplayName() string { return "Docker Hub" }
 
+// dockerHubLookup is one of the independent findings a Docker Hub check reports.
+type dockerHubLookup struct {
+	path  string
+	label string
+}
+
 func (c *DockerHubChecker) Check(ctx context.Context, name string) Result {
-	u := c.baseURL + "/v2/users/" + url.PathEscape(name)
+	escaped := url.PathEscape(name)
+	// Ordered by severity: an official image means "docker pull <name>"
+	// already resolves to someone else's software.
+	lookups := []dockerHubLookup{
+		{"/v2/repositories/library/" + escaped, "official image library/" + name},
+		{"/v2/orgs/" + escaped, "organization " + name},
+		{"/v2/users/" + escaped, "user " + name},
+	}
+
+	exists := make([]bool, len(lookups))
+	errs := make([]error, len(lookups))
+	var wg sync.WaitGroup
+	for i, l := range lookups {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			exists[i], errs[i] = c.checkEndpoint(ctx, l.path)
+		}()
+	}
+	wg.Wait()
+
+	var found []string
+	for i, l := range lookups {
+		if exists[i] {
+			found = append(found, l.label)
+		}
+	}
+
+	if len(found) > 0 {
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Taken,
+			Detail:   strings.Join(found, "\n"),
+		}
+	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	// Nothing found, but a failed lookup could have hidden a collision.
+	for _, err := range errs {
+		if err != nil {
+			return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+		}
+	}
+
+	return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+}
+
+// checkEndpoint returns (exists, error).
+func (c *DockerHubChecker) checkEndpoint(ctx context.Context, path string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
 	if err != nil {
-		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+		return false, err
 	}
 	req.Header.Set("User-Agent", "nsprobe/1.0")
 
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+		return false, err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	switch resp.StatusCode {
 	case http.StatusOK:
-		return Result{Registry: c.DisplayName(), Name: name, Status: Taken}
+		return true, nil
 	case http.StatusNotFound:
-		return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+		return false, nil
 	case http.StatusTooManyRequests:
-		return Result{
-			Registry: c.DisplayName(),
-			Name:     name,
-			Status:   Unknown,
-			Err:      fmt.Errorf("rate limited"),
-		}
+		return false, fmt.Errorf("rate limited")
 	default:
-		return Result{
-			Registry: c.DisplayName(),
-			Name:     name,
-			Status:   Unknown,
-			Err:      fmt.Errorf("unexpected status: %d", resp.StatusCode),
-		}
+		return false, fmt.Errorf("unexpected status: %d", resp.StatusCode)
 	}
 }
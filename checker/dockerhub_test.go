@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 )
 
@@ -25,6 +26,71 @@ func TestDockerHubChecker_Taken(t *testing.T) {
 	}
 }
 
+func TestDockerHubChecker_OfficialImage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v2/repositories/library/nginx" {
+			_, _ = w.Write([]byte(`{"name":"nginx","namespace":"library"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewDockerHubChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "nginx")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "official image library/nginx" {
+		t.Errorf("expected official image detail, got %q", result.Detail)
+	}
+}
+
+func TestDockerHubChecker_SeparateFindings(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/repositories/library/redis", "/v2/orgs/redis":
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewDockerHubChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "redis")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	want := "official image library/redis\norganization redis"
+	if result.Detail != want {
+		t.Errorf("expected detail %q, got %q", want, result.Detail)
+	}
+}
+
+func TestDockerHubChecker_UserOnly(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v2/users/someone" {
+			_, _ = w.Write([]byte(`{"username":"someone"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewDockerHubChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "someone")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "user someone" {
+		t.Errorf("expected detail 'user someone', got %q", result.Detail)
+	}
+}
+
 func TestDockerHubChecker_Available(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNotFound)
@@ -70,10 +136,13 @@ func TestDockerHubChecker_ServerError(t *testing.T) {
 	}
 }
 
-func TestDockerHubChecker_URLPath(t *testing.T) {
-	var receivedPath string
+func TestDockerHubChecker_URLPaths(t *testing.T) {
+	var mu sync.Mutex
+	paths := map[string]bool{}
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		receivedPath = r.URL.Path
+		mu.Lock()
+		paths[r.URL.Path] = true
+		mu.Unlock()
 		w.WriteHeader(http.StatusNotFound)
 	}))
 	defer srv.Close()
@@ -81,8 +150,14 @@ func TestDockerHubChecker_URLPath(t *testing.T) {
 	c := NewDockerHubChecker(srv.Client(), srv.URL)
 	c.Check(context.Background(), "myproject")
 
-	if receivedPath != "/v2/users/myproject" {
-		t.Errorf("expected path '/v2/users/myproject', got %q", receivedPath)
+	for _, want := range []string{
+		"/v2/users/myproject",
+		"/v2/orgs/myproject",
+		"/v2/repositories/library/myproject",
+	} {
+		if !paths[want] {
+			t.Errorf("expected request for %q, got %v", want, paths)
+		}
 	}
 }
 
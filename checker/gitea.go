@@ -0,0 +1,96 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// GiteaChecker checks user and organization availability on a Gitea or
+// Forgejo instance (Codeberg, self-hosted forges, ...).
+type GiteaChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+// NewGiteaChecker creates a GiteaChecker for the instance at baseURL
+// (e.g. https://codeberg.org).
+func NewGiteaChecker(client *http.Client, baseURL string) *GiteaChecker {
+	return &GiteaChecker{client: client, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+func (c *GiteaChecker) Name() string { return "gitea" }
+
+func (c *GiteaChecker) DisplayName() string {
+	host := c.baseURL
+	if u, err := url.Parse(c.baseURL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	return "Gitea (" + host + ")"
+}
+
+func (c *GiteaChecker) Check(ctx context.Context, name string) Result {
+	// Organizations are also served by /users, so /orgs is only needed to
+	// tell the two apart.
+	paths := []string{
+		"/api/v1/users/" + url.PathEscape(name),
+		"/api/v1/orgs/" + url.PathEscape(name),
+	}
+
+	found := make([]bool, len(paths))
+	errs := make([]error, len(paths))
+	var wg sync.WaitGroup
+	for i, path := range paths {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			found[i], errs[i] = c.checkEndpoint(ctx, c.baseURL+path)
+		}()
+	}
+	wg.Wait()
+
+	switch {
+	case found[1]:
+		return Result{Registry: c.DisplayName(), Name: name, Status: Taken, Detail: "organization"}
+	case found[0] && errs[1] != nil:
+		// /orgs failed, so this could be either.
+		return Result{Registry: c.DisplayName(), Name: name, Status: Taken, Detail: "user or organization"}
+	case found[0]:
+		return Result{Registry: c.DisplayName(), Name: name, Status: Taken, Detail: "user"}
+	}
+
+	if err := joinErrors(errs...); err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+
+	return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+}
+
+// checkEndpoint returns (exists, error).
+func (c *GiteaChecker) checkEndpoint(ctx context.Context, u string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	case http.StatusTooManyRequests:
+		return false, fmt.Errorf("rate limited")
+	default:
+		return false, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+}
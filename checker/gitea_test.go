@@ -0,0 +1,114 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGiteaChecker_TakenUser(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/users/alice" {
+			_, _ = w.Write([]byte(`{"id":1,"login":"alice"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewGiteaChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "alice")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	if result.Detail != "user" {
+		t.Errorf("expected detail 'user', got %q", result.Detail)
+	}
+}
+
+func TestGiteaChecker_TakenOrg(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/users/forgejo", "/api/v1/orgs/forgejo":
+			_, _ = w.Write([]byte(`{"id":2,"username":"forgejo"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewGiteaChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "forgejo")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	if result.Detail != "organization" {
+		t.Errorf("expected detail 'organization', got %q", result.Detail)
+	}
+}
+
+func TestGiteaChecker_TakenOrgLookupFailed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/users/forgejo":
+			_, _ = w.Write([]byte(`{"id":2,"username":"forgejo"}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewGiteaChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "forgejo")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	if result.Detail != "user or organization" {
+		t.Errorf("expected detail 'user or organization', got %q", result.Detail)
+	}
+}
+
+func TestGiteaChecker_Available(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewGiteaChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v (err: %v)", result.Status, result.Err)
+	}
+}
+
+func TestGiteaChecker_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	c := NewGiteaChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestGiteaChecker_DisplayName(t *testing.T) {
+	c := NewGiteaChecker(http.DefaultClient, "https://codeberg.org/")
+	if c.Name() != "gitea" {
+		t.Errorf("expected name 'gitea', got %q", c.Name())
+	}
+	if c.DisplayName() != "Gitea (codeberg.org)" {
+		t.Errorf("expected display name 'Gitea (codeberg.org)', got %q", c.DisplayName())
+	}
+}
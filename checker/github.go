@@ -7,6 +7,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 )
 
 // GitHubChecker checks username/organization availability on GitHub.
@@ -23,7 +25,27 @@ func NewGitHubChecker(client *http.Client, baseURL string, token string) *GitHub
 func (c *GitHubChecker) Name() string        { return "github" }
 func (c *GitHubChecker) DisplayName() string { return "GitHub" }
 
+// githubReservedNames are top-level routes on github.com. The API has no
+// account behind them, but nobody can register them either.
+var githubReservedNames = map[string]bool{
+	"about": true, "account": true, "admin": true, "api": true, "apps": true,
+	"blog": true, "business": true, "codespaces": true, "collections": true,
+	"contact": true, "copilot": true, "dashboard": true, "discussions": true,
+	"enterprise": true, "events": true, "explore": true, "features": true,
+	"gist": true, "help": true, "issues": true, "join": true, "login": true,
+	"logout": true, "marketplace": true, "new": true, "notifications": true,
+	"organizations": true, "orgs": true, "pricing": true, "pulls": true,
+	"readme": true, "search": true, "security": true, "sessions": true,
+	"settings": true, "signup": true, "site": true, "sponsors": true,
+	"stars": true, "topics": true, "trending": true, "watching": true,
+}
+
 func (c *GitHubChecker) Check(ctx context.Context, name string) Result {
+	// Logins are case-insensitive.
+	if githubReservedNames[strings.ToLower(name)] {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Reserved, Detail: "reserved for a github.com route"}
+	}
+
 	u := c.baseURL + "/users/" + url.PathEscape(name)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
@@ -44,10 +66,17 @@ func (c *GitHubChecker) Check(ctx context.Context, name string) Result {
 
 	switch resp.StatusCode {
 	case http.StatusOK:
-		detail := parseGitHubType(resp.Body)
-		return Result{Registry: c.DisplayName(), Name: name, Status: Taken, Detail: detail}
+		fields := parseGitHubAccount(resp.Body)
+		return Result{Registry: c.DisplayName(), Name: name, Status: Taken, Fields: fields}
 	case http.StatusNotFound:
-		return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+		// The API also answers 404 for suspended accounts, whose logins
+		// can't be registered.
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Available,
+			Detail:   "unless held by a suspended account",
+		}
 	case http.StatusForbidden:
 		if resp.Header.Get("X-RateLimit-Remaining") == "0" {
 			return Result{
@@ -73,12 +102,26 @@ func (c *GitHubChecker) Check(ctx context.Context, name string) Result {
 	}
 }
 
-func parseGitHubType(body io.Reader) string {
+// parseGitHubAccount extracts the account type, creation date and public
+// repository count from a /users response.
+func parseGitHubAccount(body io.Reader) []Field {
 	var data struct {
-		Type string `json:"type"`
+		Type        string `json:"type"`
+		CreatedAt   string `json:"created_at"`
+		PublicRepos *int   `json:"public_repos"`
+	}
+	if err := json.NewDecoder(io.LimitReader(body, 65536)).Decode(&data); err != nil {
+		return nil
+	}
+	var fields []Field
+	if data.Type != "" {
+		fields = append(fields, Field{Key: "type", Value: data.Type})
+	}
+	if len(data.CreatedAt) >= 10 {
+		fields = append(fields, Field{Key: "created", Value: data.CreatedAt[:10]})
 	}
-	if err := json.NewDecoder(io.LimitReader(body, 4096)).Decode(&data); err != nil {
-		return ""
+	if data.PublicRepos != nil {
+		fields = append(fields, Field{Key: "public repos", Value: strconv.Itoa(*data.PublicRepos)})
 	}
-	return data.Type
+	return fields
 }
@@ -6,9 +6,20 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 )
 
+const (
+	// githubRepoTopN is how many exact-name repositories are reported.
+	githubRepoTopN = 3
+	// githubRepoMaxPages bounds how far the search is paged. Each page is a
+	// search API call, and unauthenticated callers only get 10 a minute.
+	githubRepoMaxPages = 3
+	githubRepoPerPage  = 100
+)
+
 // GitHubRepoChecker checks if a repository with the given name exists on GitHub.
 type GitHubRepoChecker struct {
 	client  *http.Client
@@ -23,12 +34,79 @@ func NewGitHubRepoChecker(client *http.Client, baseURL string, token string) *Gi
 func (c *GitHubRepoChecker) Name() string        { return "github-repo" }
 func (c *GitHubRepoChecker) DisplayName() string { return "GitHub Repo" }
 
+type githubRepo struct {
+	Name     string `json:"name"`
+	FullName string `json:"full_name"`
+	Stars    int64  `json:"stargazers_count"`
+	PushedAt string `json:"pushed_at"`
+}
+
 func (c *GitHubRepoChecker) Check(ctx context.Context, name string) Result {
-	u := c.baseURL + "/search/repositories?q=" + name + "+in:name&per_page=5"
+	// Results are sorted by stars, so the first exact matches found are the
+	// most popular ones.
+	var matches []githubRepo
+	truncated := false
+	for page := 1; page <= githubRepoMaxPages && len(matches) < githubRepoTopN; page++ {
+		items, err := c.search(ctx, name, page)
+		if err != nil {
+			if len(matches) > 0 {
+				break
+			}
+			return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+		}
+		for _, item := range items {
+			if strings.EqualFold(item.Name, name) && len(matches) < githubRepoTopN {
+				matches = append(matches, item)
+			}
+		}
+		if len(items) < githubRepoPerPage {
+			break
+		}
+		truncated = page == githubRepoMaxPages
+	}
+
+	if len(matches) == 0 {
+		// An exact match could still be further down the results.
+		if truncated {
+			return Result{
+				Registry: c.DisplayName(),
+				Name:     name,
+				Status:   Unknown,
+				Err:      fmt.Errorf("no exact match in the first %d results, search cut short", githubRepoMaxPages*githubRepoPerPage),
+			}
+		}
+		return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+	}
+
+	lines := make([]string, len(matches))
+	for i, m := range matches {
+		lines[i] = m.FullName + " ★ " + formatCount(m.Stars)
+		if len(m.PushedAt) >= 10 {
+			lines[i] += ", pushed " + m.PushedAt[:10]
+		}
+	}
+	return Result{
+		Registry: c.DisplayName(),
+		Name:     name,
+		Status:   Taken,
+		Detail:   strings.Join(lines, "\n"),
+	}
+}
+
+// search fetches one page of repositories whose name contains name, most
+// starred first.
+func (c *GitHubRepoChecker) search(ctx context.Context, name string, page int) ([]githubRepo, error) {
+	q := url.Values{}
+	q.Set("q", name+" in:name")
+	q.Set("sort", "stars")
+	q.Set("order", "desc")
+	q.Set("per_page", strconv.Itoa(githubRepoPerPage))
+	q.Set("page", strconv.Itoa(page))
+	u := c.baseURL + "/search/repositories?" + q.Encode()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+		return nil, err
 	}
 	req.Header.Set("Accept", "application/vnd.github+json")
 	req.Header.Set("User-Agent", "nsprobe/1.0")
@@ -38,48 +116,22 @@ func (c *GitHubRepoChecker) Check(ctx context.Context, name string) Result {
 
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+		return nil, err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	switch resp.StatusCode {
 	case http.StatusOK:
-		fullName := findExactRepoMatch(resp.Body, name)
-		if fullName != "" {
-			return Result{Registry: c.DisplayName(), Name: name, Status: Taken, Detail: fullName}
+		var data struct {
+			Items []githubRepo `json:"items"`
 		}
-		return Result{Registry: c.DisplayName(), Name: name, Status: Available}
-	case http.StatusForbidden:
-		return Result{
-			Registry: c.DisplayName(),
-			Name:     name,
-			Status:   Unknown,
-			Err:      fmt.Errorf("rate limited"),
+		if err := json.NewDecoder(io.LimitReader(resp.Body, 4<<20)).Decode(&data); err != nil {
+			return nil, err
 		}
+		return data.Items, nil
+	case http.StatusForbidden, http.StatusTooManyRequests:
+		return nil, fmt.Errorf("rate limited")
 	default:
-		return Result{
-			Registry: c.DisplayName(),
-			Name:     name,
-			Status:   Unknown,
-			Err:      fmt.Errorf("unexpected status: %d", resp.StatusCode),
-		}
-	}
-}
-
-func findExactRepoMatch(body io.Reader, name string) string {
-	var data struct {
-		Items []struct {
-			Name     string `json:"name"`
-			FullName string `json:"full_name"`
-		} `json:"items"`
-	}
-	if err := json.NewDecoder(io.LimitReader(body, 65536)).Decode(&data); err != nil {
-		return ""
-	}
-	for _, item := range data.Items {
-		if strings.EqualFold(item.Name, name) {
-			return item.FullName
-		}
+		return nil, fmt.Errorf("unexpected status: %d", resp.StatusCode)
 	}
-	return ""
 }
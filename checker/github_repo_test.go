@@ -4,6 +4,8 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 )
 
@@ -11,7 +13,7 @@ func TestGitHubRepoChecker_Taken(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte(`{"total_count":1,"items":[{"name":"react","full_name":"facebook/react","stargazers_count":200000}]}`))
+		_, _ = w.Write([]byte(`{"total_count":1,"items":[{"name":"react","full_name":"facebook/react","stargazers_count":200000,"pushed_at":"2024-05-01T12:00:00Z"}]}`))
 	}))
 	defer srv.Close()
 
@@ -21,8 +23,8 @@ func TestGitHubRepoChecker_Taken(t *testing.T) {
 	if result.Status != Taken {
 		t.Errorf("expected Taken, got %v", result.Status)
 	}
-	if result.Detail != "facebook/react" {
-		t.Errorf("expected detail 'facebook/react', got %q", result.Detail)
+	if result.Detail != "facebook/react ★ 200,000, pushed 2024-05-01" {
+		t.Errorf("expected detail 'facebook/react ★ 200,000, pushed 2024-05-01', got %q", result.Detail)
 	}
 }
 
@@ -92,10 +94,10 @@ func TestGitHubRepoChecker_TokenSent(t *testing.T) {
 	}
 }
 
-func TestGitHubRepoChecker_QueryParam(t *testing.T) {
-	var receivedQuery string
+func TestGitHubRepoChecker_QueryParams(t *testing.T) {
+	var received url.Values
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		receivedQuery = r.URL.Query().Get("q")
+		received = r.URL.Query()
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write([]byte(`{"total_count":0,"items":[]}`))
@@ -103,10 +105,109 @@ func TestGitHubRepoChecker_QueryParam(t *testing.T) {
 	defer srv.Close()
 
 	c := NewGitHubRepoChecker(srv.Client(), srv.URL, "")
-	c.Check(context.Background(), "myproject")
+	c.Check(context.Background(), "my+project&x")
+
+	want := map[string]string{
+		"q":        "my+project&x in:name",
+		"sort":     "stars",
+		"order":    "desc",
+		"per_page": "100",
+		"page":     "1",
+	}
+	for k, v := range want {
+		if received.Get(k) != v {
+			t.Errorf("expected %s=%q, got %q", k, v, received.Get(k))
+		}
+	}
+}
+
+func TestGitHubRepoChecker_TopMatchesAcrossPages(t *testing.T) {
+	var pages []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		w.Header().Set("Content-Type", "application/json")
+		if page == "1" {
+			// A full page with a single exact match among near-misses.
+			items := []string{`{"name":"tool","full_name":"big/tool","stargazers_count":40000,"pushed_at":"2024-06-01T00:00:00Z"}`}
+			for i := 1; i < 100; i++ {
+				items = append(items, `{"name":"tool-extra","full_name":"x/tool-extra","stargazers_count":10}`)
+			}
+			_, _ = w.Write([]byte(`{"items":[` + strings.Join(items, ",") + `]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"items":[` +
+			`{"name":"Tool","full_name":"mid/Tool","stargazers_count":1200,"pushed_at":"2023-01-02T00:00:00Z"},` +
+			`{"name":"tool","full_name":"small/tool","stargazers_count":5,"pushed_at":"2020-03-04T00:00:00Z"},` +
+			`{"name":"tool","full_name":"fork/tool","stargazers_count":0,"pushed_at":"2019-01-01T00:00:00Z"}]}`))
+	}))
+	defer srv.Close()
+
+	c := NewGitHubRepoChecker(srv.Client(), srv.URL, "")
+	result := c.Check(context.Background(), "tool")
 
-	if receivedQuery != "myproject in:name" {
-		t.Errorf("expected query 'myproject in:name', got %q", receivedQuery)
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	want := "big/tool ★ 40,000, pushed 2024-06-01\n" +
+		"mid/Tool ★ 1,200, pushed 2023-01-02\n" +
+		"small/tool ★ 5, pushed 2020-03-04"
+	if result.Detail != want {
+		t.Errorf("expected detail %q, got %q", want, result.Detail)
+	}
+	if strings.Join(pages, ",") != "1,2" {
+		t.Errorf("expected pages 1,2 to be fetched, got %v", pages)
+	}
+}
+
+func TestGitHubRepoChecker_LaterPageErrorKeepsMatches(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") != "1" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		items := []string{`{"name":"tool","full_name":"big/tool","stargazers_count":7}`}
+		for i := 1; i < 100; i++ {
+			items = append(items, `{"name":"tool-extra","full_name":"x/tool-extra","stargazers_count":1}`)
+		}
+		_, _ = w.Write([]byte(`{"items":[` + strings.Join(items, ",") + `]}`))
+	}))
+	defer srv.Close()
+
+	c := NewGitHubRepoChecker(srv.Client(), srv.URL, "")
+	result := c.Check(context.Background(), "tool")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	if result.Detail != "big/tool ★ 7" {
+		t.Errorf("expected detail 'big/tool ★ 7', got %q", result.Detail)
+	}
+}
+
+func TestGitHubRepoChecker_TruncatedSearchIsUnknown(t *testing.T) {
+	var pages []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages = append(pages, r.URL.Query().Get("page"))
+		items := make([]string, 100)
+		for i := range items {
+			items[i] = `{"name":"tool-extra","full_name":"x/tool-extra","stargazers_count":1}`
+		}
+		_, _ = w.Write([]byte(`{"items":[` + strings.Join(items, ",") + `]}`))
+	}))
+	defer srv.Close()
+
+	c := NewGitHubRepoChecker(srv.Client(), srv.URL, "")
+	result := c.Check(context.Background(), "tool")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+	if strings.Join(pages, ",") != "1,2,3" {
+		t.Errorf("expected pages 1,2,3 to be fetched, got %v", pages)
 	}
 }
 
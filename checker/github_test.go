@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"testing"
 )
 
@@ -11,7 +12,7 @@ func TestGitHubChecker_Taken(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte(`{"login":"octocat","type":"User"}`))
+		_, _ = w.Write([]byte(`{"login":"octocat","type":"User","created_at":"2011-01-25T18:44:36Z","public_repos":8}`))
 	}))
 	defer srv.Close()
 
@@ -21,8 +22,13 @@ func TestGitHubChecker_Taken(t *testing.T) {
 	if result.Status != Taken {
 		t.Errorf("expected Taken, got %v", result.Status)
 	}
-	if result.Detail != "User" {
-		t.Errorf("expected detail 'User', got %q", result.Detail)
+	want := []Field{
+		{Key: "type", Value: "User"},
+		{Key: "created", Value: "2011-01-25"},
+		{Key: "public repos", Value: "8"},
+	}
+	if !slices.Equal(result.Fields, want) {
+		t.Errorf("expected fields %v, got %v", want, result.Fields)
 	}
 }
 
@@ -30,7 +36,7 @@ func TestGitHubChecker_TakenOrg(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte(`{"login":"github","type":"Organization"}`))
+		_, _ = w.Write([]byte(`{"login":"github","type":"Organization","public_repos":0}`))
 	}))
 	defer srv.Close()
 
@@ -40,8 +46,12 @@ func TestGitHubChecker_TakenOrg(t *testing.T) {
 	if result.Status != Taken {
 		t.Errorf("expected Taken, got %v", result.Status)
 	}
-	if result.Detail != "Organization" {
-		t.Errorf("expected detail 'Organization', got %q", result.Detail)
+	want := []Field{
+		{Key: "type", Value: "Organization"},
+		{Key: "public repos", Value: "0"},
+	}
+	if !slices.Equal(result.Fields, want) {
+		t.Errorf("expected fields %v, got %v", want, result.Fields)
 	}
 }
 
@@ -57,6 +67,29 @@ func TestGitHubChecker_Available(t *testing.T) {
 	if result.Status != Available {
 		t.Errorf("expected Available, got %v", result.Status)
 	}
+	if result.Detail != "unless held by a suspended account" {
+		t.Errorf("expected suspended-account note, got %q", result.Detail)
+	}
+}
+
+func TestGitHubChecker_ReservedRoute(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewGitHubChecker(srv.Client(), srv.URL, "")
+	for _, name := range []string{"settings", "Marketplace", "sponsors"} {
+		result := c.Check(context.Background(), name)
+		if result.Status != Reserved {
+			t.Errorf("%s: expected Reserved, got %v", name, result.Status)
+		}
+	}
+	if requests != 0 {
+		t.Errorf("expected no requests for reserved routes, got %d", requests)
+	}
 }
 
 func TestGitHubChecker_RateLimited(t *testing.T) {
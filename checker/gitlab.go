@@ -0,0 +1,193 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// GitLabChecker checks user, group and project availability on GitLab.com or
+// a self-managed GitLab instance.
+type GitLabChecker struct {
+	client  *http.Client
+	baseURL string
+	token   string
+}
+
+// NewGitLabChecker creates a GitLabChecker for the instance at baseURL
+// (e.g. https://gitlab.com). token is sent as a personal access token when set.
+func NewGitLabChecker(client *http.Client, baseURL string, token string) *GitLabChecker {
+	return &GitLabChecker{client: client, baseURL: strings.TrimSuffix(baseURL, "/"), token: token}
+}
+
+func (c *GitLabChecker) Name() string { return "gitlab" }
+
+func (c *GitLabChecker) DisplayName() string {
+	u, err := url.Parse(c.baseURL)
+	if err != nil || u.Host == "" || u.Host == "gitlab.com" {
+		return "GitLab"
+	}
+	return "GitLab (" + u.Host + ")"
+}
+
+func (c *GitLabChecker) Check(ctx context.Context, name string) Result {
+	lookups := []func(context.Context, string) (string, error){
+		c.lookupNamespace,
+		c.lookupProject,
+	}
+
+	found := make([]string, len(lookups))
+	errs := make([]error, len(lookups))
+	var wg sync.WaitGroup
+	for i, lookup := range lookups {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			found[i], errs[i] = lookup(ctx, name)
+		}()
+	}
+	wg.Wait()
+
+	var lines []string
+	for _, f := range found {
+		if f != "" {
+			lines = append(lines, f)
+		}
+	}
+
+	if len(lines) > 0 {
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Taken,
+			Detail:   strings.Join(lines, "\n"),
+		}
+	}
+
+	if err := joinErrors(errs...); err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+
+	return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+}
+
+// lookupNamespace resolves name to a user or group namespace. Users and
+// groups share one namespace on GitLab, so either one takes the name.
+func (c *GitLabChecker) lookupNamespace(ctx context.Context, name string) (string, error) {
+	var data struct {
+		Kind     string `json:"kind"`
+		FullPath string `json:"full_path"`
+	}
+	found, err := c.getJSON(ctx, "/api/v4/namespaces/"+url.PathEscape(name), &data)
+	if errors.Is(err, errGitLabUnauthorized) {
+		// The namespaces API needs a token; anonymous callers can still
+		// find users and public groups.
+		return c.lookupUserOrGroup(ctx, name)
+	}
+	if err != nil {
+		return "", fmt.Errorf("namespace: %w", err)
+	}
+	if !found {
+		return "", nil
+	}
+	path := data.FullPath
+	if path == "" {
+		path = name
+	}
+	switch data.Kind {
+	case "user":
+		return "user " + path, nil
+	case "group":
+		return "group " + path, nil
+	default:
+		return "namespace " + path, nil
+	}
+}
+
+// lookupUserOrGroup is the anonymous fallback for lookupNamespace.
+func (c *GitLabChecker) lookupUserOrGroup(ctx context.Context, name string) (string, error) {
+	var users []struct {
+		Username string `json:"username"`
+	}
+	if _, err := c.getJSON(ctx, "/api/v4/users?username="+url.QueryEscape(name), &users); err != nil {
+		return "", fmt.Errorf("users: %w", err)
+	}
+	if len(users) > 0 {
+		return "user " + users[0].Username, nil
+	}
+
+	var group struct {
+		FullPath string `json:"full_path"`
+	}
+	found, err := c.getJSON(ctx, "/api/v4/groups/"+url.PathEscape(name), &group)
+	if err != nil {
+		return "", fmt.Errorf("groups: %w", err)
+	}
+	if !found {
+		return "", nil
+	}
+	if group.FullPath == "" {
+		group.FullPath = name
+	}
+	return "group " + group.FullPath, nil
+}
+
+// lookupProject looks for the <name>/<name> project.
+func (c *GitLabChecker) lookupProject(ctx context.Context, name string) (string, error) {
+	var data struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	}
+	found, err := c.getJSON(ctx, "/api/v4/projects/"+url.PathEscape(name+"/"+name), &data)
+	if err != nil {
+		return "", fmt.Errorf("project: %w", err)
+	}
+	if !found {
+		return "", nil
+	}
+	if data.PathWithNamespace == "" {
+		data.PathWithNamespace = name + "/" + name
+	}
+	return "project " + data.PathWithNamespace, nil
+}
+
+var errGitLabUnauthorized = errors.New("unauthorized (set GITLAB_TOKEN)")
+
+// getJSON decodes the response for path into v. It returns false for 404.
+func (c *GitLabChecker) getJSON(ctx context.Context, path string, v any) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+	if c.token != "" {
+		req.Header.Set("PRIVATE-TOKEN", c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if err := json.NewDecoder(io.LimitReader(resp.Body, 65536)).Decode(v); err != nil {
+			return false, err
+		}
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	case http.StatusUnauthorized:
+		return false, errGitLabUnauthorized
+	case http.StatusTooManyRequests:
+		return false, fmt.Errorf("rate limited")
+	default:
+		return false, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+}
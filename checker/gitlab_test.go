@@ -0,0 +1,176 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGitLabChecker_TakenUser(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/namespaces/alice" {
+			_, _ = w.Write([]byte(`{"id":1,"kind":"user","full_path":"alice"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewGitLabChecker(srv.Client(), srv.URL, "glpat-test")
+	result := c.Check(context.Background(), "alice")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	if result.Detail != "user alice" {
+		t.Errorf("expected detail 'user alice', got %q", result.Detail)
+	}
+}
+
+func TestGitLabChecker_TakenGroupAndProject(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/api/v4/namespaces/gitlab-org":
+			_, _ = w.Write([]byte(`{"id":9970,"kind":"group","full_path":"gitlab-org"}`))
+		case "/api/v4/projects/gitlab-org%2Fgitlab-org":
+			_, _ = w.Write([]byte(`{"id":1,"path_with_namespace":"gitlab-org/gitlab-org"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewGitLabChecker(srv.Client(), srv.URL, "glpat-test")
+	result := c.Check(context.Background(), "gitlab-org")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	want := "group gitlab-org\nproject gitlab-org/gitlab-org"
+	if result.Detail != want {
+		t.Errorf("expected detail %q, got %q", want, result.Detail)
+	}
+}
+
+func TestGitLabChecker_AnonymousFallback(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/namespaces/bob":
+			w.WriteHeader(http.StatusUnauthorized)
+		case "/api/v4/users":
+			if r.URL.Query().Get("username") != "bob" {
+				t.Errorf("expected username=bob, got %q", r.URL.Query().Get("username"))
+			}
+			_, _ = w.Write([]byte(`[{"id":2,"username":"bob"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewGitLabChecker(srv.Client(), srv.URL, "")
+	result := c.Check(context.Background(), "bob")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	if result.Detail != "user bob" {
+		t.Errorf("expected detail 'user bob', got %q", result.Detail)
+	}
+}
+
+func TestGitLabChecker_AnonymousFallbackGroup(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/namespaces/team":
+			w.WriteHeader(http.StatusUnauthorized)
+		case "/api/v4/users":
+			_, _ = w.Write([]byte(`[]`))
+		case "/api/v4/groups/team":
+			_, _ = w.Write([]byte(`{"id":3,"full_path":"team"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewGitLabChecker(srv.Client(), srv.URL, "")
+	result := c.Check(context.Background(), "team")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	if result.Detail != "group team" {
+		t.Errorf("expected detail 'group team', got %q", result.Detail)
+	}
+}
+
+func TestGitLabChecker_Available(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewGitLabChecker(srv.Client(), srv.URL, "glpat-test")
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v (err: %v)", result.Status, result.Err)
+	}
+}
+
+func TestGitLabChecker_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewGitLabChecker(srv.Client(), srv.URL, "")
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestGitLabChecker_TokenSent(t *testing.T) {
+	tokens := make(chan string, 2)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokens <- r.Header.Get("PRIVATE-TOKEN")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewGitLabChecker(srv.Client(), srv.URL, "glpat-test")
+	c.Check(context.Background(), "test")
+	close(tokens)
+
+	for tok := range tokens {
+		if tok != "glpat-test" {
+			t.Errorf("expected PRIVATE-TOKEN 'glpat-test', got %q", tok)
+		}
+	}
+}
+
+func TestGitLabChecker_DisplayName(t *testing.T) {
+	tests := []struct {
+		baseURL string
+		want    string
+	}{
+		{"https://gitlab.com", "GitLab"},
+		{"https://gitlab.example.com/", "GitLab (gitlab.example.com)"},
+	}
+	for _, tt := range tests {
+		c := NewGitLabChecker(http.DefaultClient, tt.baseURL, "")
+		if c.Name() != "gitlab" {
+			t.Errorf("expected name 'gitlab', got %q", c.Name())
+		}
+		if c.DisplayName() != tt.want {
+			t.Errorf("expected display name %q, got %q", tt.want, c.DisplayName())
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package checker
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// GoModChecker checks whether the conventional module paths for a name are
+// already published, using the Go module proxy protocol.
+type GoModChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewGoModChecker(client *http.Client, baseURL string) *GoModChecker {
+	return &GoModChecker{client: client, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+func (c *GoModChecker) Name() string        { return "gomod" }
+func (c *GoModChecker) DisplayName() string { return "Go modules" }
+
+// goModuleCandidates returns the module paths a project named name would
+// most likely be published under. github.com/<name> alone is not a valid
+// module path, since GitHub modules need both an owner and a repository.
+func goModuleCandidates(name string) []string {
+	return []string{
+		"github.com/" + name + "/" + name,
+		name + ".dev",
+	}
+}
+
+func (c *GoModChecker) Check(ctx context.Context, name string) Result {
+	candidates := goModuleCandidates(name)
+	exists := make([]bool, len(candidates))
+	errs := make([]error, len(candidates))
+
+	var wg sync.WaitGroup
+	for i, path := range candidates {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			exists[i], errs[i] = c.lookupModule(ctx, path)
+		}()
+	}
+	wg.Wait()
+
+	var found []string
+	for i, path := range candidates {
+		if exists[i] {
+			found = append(found, path)
+		}
+	}
+
+	if len(found) > 0 {
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Taken,
+			Detail:   strings.Join(found, ", "),
+		}
+	}
+
+	// Nothing found, but a failed lookup could have hidden a collision.
+	if err := joinErrors(errs...); err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+
+	return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+}
+
+// lookupModule reports whether the proxy knows any version of the module.
+// A module with only pseudo-versions has an empty @v/list, so @latest is
+// consulted before declaring it absent.
+func (c *GoModChecker) lookupModule(ctx context.Context, path string) (bool, error) {
+	base := c.baseURL + "/" + escapeModulePath(path)
+
+	body, found, err := c.get(ctx, base+"/@v/list")
+	if err != nil || !found {
+		return false, err
+	}
+	if hasVersionLine(body) {
+		return true, nil
+	}
+
+	_, found, err = c.get(ctx, base+"/@latest")
+	return found, err
+}
+
+// get fetches u and returns the body for 200, found=false for 404/410
+// (the proxy protocol's "not found" codes), and an error otherwise.
+func (c *GoModChecker) get(ctx context.Context, u string) (string, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return "", false, err
+	}
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		b, err := io.ReadAll(io.LimitReader(resp.Body, 65536))
+		if err != nil {
+			return "", false, err
+		}
+		return string(b), true, nil
+	case http.StatusNotFound, http.StatusGone:
+		return "", false, nil
+	default:
+		return "", false, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+}
+
+func hasVersionLine(body string) bool {
+	sc := bufio.NewScanner(strings.NewReader(body))
+	for sc.Scan() {
+		if strings.TrimSpace(sc.Text()) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// escapeModulePath applies the proxy protocol's case encoding, replacing each
+// upper-case letter with '!' followed by its lower-case form.
+func escapeModulePath(path string) string {
+	var b strings.Builder
+	for _, r := range path {
+		if 'A' <= r && r <= 'Z' {
+			b.WriteByte('!')
+			b.WriteRune(r + ('a' - 'A'))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,163 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGoModChecker_TakenTaggedModule(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/github.com/cobra/cobra/@v/list" {
+			_, _ = w.Write([]byte("v1.0.0\nv1.8.0\n"))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewGoModChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "cobra")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "github.com/cobra/cobra" {
+		t.Errorf("expected detail 'github.com/cobra/cobra', got %q", result.Detail)
+	}
+}
+
+func TestGoModChecker_TakenPseudoVersionOnly(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/myproject.dev/@v/list":
+			w.WriteHeader(http.StatusOK)
+		case "/myproject.dev/@latest":
+			_, _ = w.Write([]byte(`{"Version":"v0.0.0-20240101000000-abcdefabcdef"}`))
+		default:
+			w.WriteHeader(http.StatusGone)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewGoModChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "myproject")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "myproject.dev" {
+		t.Errorf("expected detail 'myproject.dev', got %q", result.Detail)
+	}
+}
+
+func TestGoModChecker_Available(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer srv.Close()
+
+	c := NewGoModChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+}
+
+func TestGoModChecker_ErrorWithoutMatchIsUnknown(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/github.com/test/test/@v/list" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewGoModChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestGoModChecker_ErrorsOnOneLine(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewGoModChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	// output prints Err on the result's row, so it must not wrap.
+	if result.Err == nil || strings.Contains(result.Err.Error(), "\n") || !strings.Contains(result.Err.Error(), "; ") {
+		t.Errorf("expected both failures joined on one line, got %q", result.Err)
+	}
+}
+
+func TestGoModChecker_Timeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(5 * time.Second)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	c := NewGoModChecker(srv.Client(), srv.URL)
+	result := c.Check(ctx, "anything")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown on timeout, got %v", result.Status)
+	}
+}
+
+func TestGoModChecker_URLPaths(t *testing.T) {
+	var mu sync.Mutex
+	paths := map[string]bool{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths[r.URL.Path] = true
+		mu.Unlock()
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewGoModChecker(srv.Client(), srv.URL+"/")
+	c.Check(context.Background(), "MyProject")
+
+	for _, want := range []string{
+		"/github.com/!my!project/!my!project/@v/list",
+		"/!my!project.dev/@v/list",
+	} {
+		if !paths[want] {
+			t.Errorf("expected request for %q, got %v", want, paths)
+		}
+	}
+	if paths["/github.com/!my!project/@v/list"] {
+		t.Errorf("expected no request for the invalid path github.com/MyProject, got %v", paths)
+	}
+}
+
+func TestGoModChecker_Name(t *testing.T) {
+	c := NewGoModChecker(http.DefaultClient, "")
+	if c.Name() != "gomod" {
+		t.Errorf("expected name 'gomod', got %q", c.Name())
+	}
+	if c.DisplayName() != "Go modules" {
+		t.Errorf("expected display name 'Go modules', got %q", c.DisplayName())
+	}
+}
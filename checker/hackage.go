@@ -0,0 +1,162 @@
+package checker
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// HackageChecker checks Haskell package name availability on Hackage.
+type HackageChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewHackageChecker(client *http.Client, baseURL string) *HackageChecker {
+	return &HackageChecker{client: client, baseURL: baseURL}
+}
+
+func (c *HackageChecker) Name() string        { return "hackage" }
+func (c *HackageChecker) DisplayName() string { return "Hackage" }
+
+// Check resolves name against the full package index rather than the
+// case-sensitive package URL: Hackage refuses uploads whose name differs from
+// an existing package only by case (unlike npm, which lowercases everything).
+func (c *HackageChecker) Check(ctx context.Context, name string) Result {
+	existing, err := c.findPackage(ctx, name)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	if existing == "" {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+	}
+
+	var (
+		synopsis   string
+		deprecated string
+		wg         sync.WaitGroup
+	)
+	// Synopsis and deprecation are nice-to-haves; failures leave them blank.
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		synopsis = c.fetchSynopsis(ctx, existing)
+	}()
+	go func() {
+		defer wg.Done()
+		deprecated = c.fetchDeprecation(ctx, existing)
+	}()
+	wg.Wait()
+
+	var lines []string
+	if existing != name {
+		lines = append(lines, "conflicts with "+existing+" (names are case-insensitive)")
+	}
+	if synopsis != "" {
+		lines = append(lines, synopsis)
+	}
+	if deprecated != "" {
+		lines = append(lines, deprecated)
+	}
+
+	return Result{
+		Registry: c.DisplayName(),
+		Name:     name,
+		Status:   Taken,
+		Detail:   strings.Join(lines, "\n"),
+	}
+}
+
+// findPackage returns the existing package whose name equals name ignoring
+// case, or "" if there is none.
+func (c *HackageChecker) findPackage(ctx context.Context, name string) (string, error) {
+	resp, err := c.get(ctx, "/packages/", "application/json")
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+
+	var pkgs []struct {
+		PackageName string `json:"packageName"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 16<<20)).Decode(&pkgs); err != nil {
+		return "", err
+	}
+	for _, p := range pkgs {
+		if strings.EqualFold(p.PackageName, name) {
+			return p.PackageName, nil
+		}
+	}
+	return "", nil
+}
+
+// fetchSynopsis reads the "synopsis:" field from the latest .cabal file.
+func (c *HackageChecker) fetchSynopsis(ctx context.Context, pkg string) string {
+	resp, err := c.get(ctx, "/package/"+url.PathEscape(pkg)+"/"+url.PathEscape(pkg)+".cabal", "text/plain")
+	if err != nil {
+		return ""
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return ""
+	}
+
+	sc := bufio.NewScanner(io.LimitReader(resp.Body, 1<<20))
+	for sc.Scan() {
+		field, value, ok := strings.Cut(sc.Text(), ":")
+		if ok && strings.EqualFold(strings.TrimSpace(field), "synopsis") {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+// fetchDeprecation describes the package's deprecation status, or returns ""
+// if it isn't deprecated.
+func (c *HackageChecker) fetchDeprecation(ctx context.Context, pkg string) string {
+	resp, err := c.get(ctx, "/package/"+url.PathEscape(pkg)+"/deprecated", "application/json")
+	if err != nil {
+		return ""
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return ""
+	}
+
+	var data struct {
+		IsDeprecated bool     `json:"is-deprecated"`
+		InFavourOf   []string `json:"in-favour-of"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 65536)).Decode(&data); err != nil {
+		return ""
+	}
+	if !data.IsDeprecated {
+		return ""
+	}
+	if len(data.InFavourOf) > 0 {
+		return "deprecated in favour of " + strings.Join(data.InFavourOf, ", ")
+	}
+	return "deprecated"
+}
+
+func (c *HackageChecker) get(ctx context.Context, path, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+	return c.client.Do(req)
+}
@@ -0,0 +1,121 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newHackageServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/packages/":
+			if r.Header.Get("Accept") != "application/json" {
+				t.Errorf("expected JSON Accept header for package index, got %q", r.Header.Get("Accept"))
+			}
+			_, _ = w.Write([]byte(`[{"packageName":"aeson"},{"packageName":"HUnit"},{"packageName":"parsec"}]`))
+		case "/package/aeson/aeson.cabal":
+			_, _ = w.Write([]byte("cabal-version: 2.2\nname: aeson\nSynopsis:   Fast JSON parsing and encoding\n"))
+		case "/package/aeson/deprecated":
+			_, _ = w.Write([]byte(`{"is-deprecated":false,"in-favour-of":[]}`))
+		case "/package/HUnit/HUnit.cabal":
+			_, _ = w.Write([]byte("name: HUnit\nsynopsis: A unit testing framework for Haskell\n"))
+		case "/package/HUnit/deprecated":
+			_, _ = w.Write([]byte(`{"is-deprecated":true,"in-favour-of":["tasty-hunit"]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestHackageChecker_Taken(t *testing.T) {
+	srv := newHackageServer(t)
+	defer srv.Close()
+
+	c := NewHackageChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "aeson")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "Fast JSON parsing and encoding" {
+		t.Errorf("expected synopsis detail, got %q", result.Detail)
+	}
+}
+
+func TestHackageChecker_CaseInsensitiveCollision(t *testing.T) {
+	srv := newHackageServer(t)
+	defer srv.Close()
+
+	c := NewHackageChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "hunit")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	want := "conflicts with HUnit (names are case-insensitive)\n" +
+		"A unit testing framework for Haskell\n" +
+		"deprecated in favour of tasty-hunit"
+	if result.Detail != want {
+		t.Errorf("expected detail:\n%s\ngot:\n%s", want, result.Detail)
+	}
+}
+
+func TestHackageChecker_Available(t *testing.T) {
+	srv := newHackageServer(t)
+	defer srv.Close()
+
+	c := NewHackageChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+}
+
+func TestHackageChecker_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewHackageChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestHackageChecker_Timeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(5 * time.Second)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	c := NewHackageChecker(srv.Client(), srv.URL)
+	result := c.Check(ctx, "anything")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown on timeout, got %v", result.Status)
+	}
+}
+
+func TestHackageChecker_Name(t *testing.T) {
+	c := NewHackageChecker(http.DefaultClient, "")
+	if c.Name() != "hackage" {
+		t.Errorf("expected name 'hackage', got %q", c.Name())
+	}
+	if c.DisplayName() != "Hackage" {
+		t.Errorf("expected display name 'Hackage', got %q", c.DisplayName())
+	}
+}
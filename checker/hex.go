@@ -0,0 +1,131 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// HexChecker checks package name availability on Hex.pm (Elixir and Erlang).
+type HexChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewHexChecker(client *http.Client, baseURL string) *HexChecker {
+	return &HexChecker{client: client, baseURL: baseURL}
+}
+
+func (c *HexChecker) Name() string        { return "hex" }
+func (c *HexChecker) DisplayName() string { return "Hex.pm" }
+
+func (c *HexChecker) Check(ctx context.Context, name string) Result {
+	u := c.baseURL + "/api/packages/" + url.PathEscape(name)
+
+	// Owners only matter if the package exists, but fetching them alongside
+	// the package saves a round trip when it does.
+	owners := make(chan []string, 1)
+	go func() { owners <- c.fetchOwners(ctx, name) }()
+
+	resp, err := c.get(ctx, u)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		released := parseHexLastRelease(resp.Body)
+		var parts []string
+		// Owners are a nice-to-have; a failed lookup doesn't change the verdict.
+		if o := <-owners; len(o) > 0 {
+			parts = append(parts, "owners: "+strings.Join(o, ", "))
+		}
+		if released != "" {
+			parts = append(parts, "last release "+released)
+		}
+		return Result{Registry: c.DisplayName(), Name: name, Status: Taken, Detail: strings.Join(parts, ", ")}
+	case http.StatusNotFound:
+		return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+	case http.StatusTooManyRequests, http.StatusForbidden:
+		if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+			return Result{
+				Registry: c.DisplayName(),
+				Name:     name,
+				Status:   Unknown,
+				Err:      fmt.Errorf("rate limited"),
+			}
+		}
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Unknown,
+			Err:      fmt.Errorf("unexpected status: %d", resp.StatusCode),
+		}
+	default:
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Unknown,
+			Err:      fmt.Errorf("unexpected status: %d", resp.StatusCode),
+		}
+	}
+}
+
+func (c *HexChecker) get(ctx context.Context, u string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+	return c.client.Do(req)
+}
+
+func (c *HexChecker) fetchOwners(ctx context.Context, name string) []string {
+	resp, err := c.get(ctx, c.baseURL+"/api/packages/"+url.PathEscape(name)+"/owners")
+	if err != nil {
+		return nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil
+	}
+
+	var data []struct {
+		Username string `json:"username"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 65536)).Decode(&data); err != nil {
+		return nil
+	}
+	var owners []string
+	for _, o := range data {
+		if o.Username != "" {
+			owners = append(owners, o.Username)
+		}
+	}
+	return owners
+}
+
+// parseHexLastRelease returns the date (YYYY-MM-DD) of the newest release.
+// Hex lists releases newest first.
+func parseHexLastRelease(body io.Reader) string {
+	var data struct {
+		Releases []struct {
+			InsertedAt string `json:"inserted_at"`
+		} `json:"releases"`
+	}
+	if err := json.NewDecoder(io.LimitReader(body, 1<<20)).Decode(&data); err != nil {
+		return ""
+	}
+	if len(data.Releases) == 0 {
+		return ""
+	}
+	date, _, _ := strings.Cut(data.Releases[0].InsertedAt, "T")
+	return date
+}
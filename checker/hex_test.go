@@ -0,0 +1,151 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestHexChecker_Taken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/packages/phoenix":
+			_, _ = w.Write([]byte(`{"name":"phoenix","releases":[` +
+				`{"version":"1.7.14","inserted_at":"2024-06-18T12:00:00.000000Z"},` +
+				`{"version":"1.7.13","inserted_at":"2024-06-01T09:00:00.000000Z"}]}`))
+		case "/api/packages/phoenix/owners":
+			_, _ = w.Write([]byte(`[{"username":"chrismccord"},{"username":"josevalim"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewHexChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "phoenix")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	want := "owners: chrismccord, josevalim, last release 2024-06-18"
+	if result.Detail != want {
+		t.Errorf("expected detail %q, got %q", want, result.Detail)
+	}
+}
+
+func TestHexChecker_Available(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewHexChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+}
+
+func TestHexChecker_RateLimited(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-ratelimit-limit", "100")
+		w.Header().Set("x-ratelimit-remaining", "0")
+		w.Header().Set("x-ratelimit-reset", "1700000000")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	c := NewHexChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil || result.Err.Error() != "rate limited" {
+		t.Errorf("expected 'rate limited' error, got %v", result.Err)
+	}
+}
+
+func TestHexChecker_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewHexChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestHexChecker_URLPath(t *testing.T) {
+	var mu sync.Mutex
+	paths := map[string]bool{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths[r.URL.Path] = true
+		mu.Unlock()
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewHexChecker(srv.Client(), srv.URL)
+	c.Check(context.Background(), "myproject")
+
+	mu.Lock()
+	defer mu.Unlock()
+	if !paths["/api/packages/myproject"] {
+		t.Errorf("expected path '/api/packages/myproject', got %v", paths)
+	}
+}
+
+func TestHexChecker_OwnersFetchedInParallel(t *testing.T) {
+	ownersRequested := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/packages/phoenix":
+			// Only answer once the owners request is in flight too.
+			select {
+			case <-ownersRequested:
+			case <-time.After(2 * time.Second):
+				t.Error("owners were not requested alongside the package")
+			}
+			_, _ = w.Write([]byte(`{"name":"phoenix","releases":[]}`))
+		case "/api/packages/phoenix/owners":
+			close(ownersRequested)
+			_, _ = w.Write([]byte(`[{"username":"josevalim"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewHexChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "phoenix")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "owners: josevalim" {
+		t.Errorf("expected detail 'owners: josevalim', got %q", result.Detail)
+	}
+}
+
+func TestHexChecker_Name(t *testing.T) {
+	c := NewHexChecker(http.DefaultClient, "")
+	if c.Name() != "hex" {
+		t.Errorf("expected name 'hex', got %q", c.Name())
+	}
+	if c.DisplayName() != "Hex.pm" {
+		t.Errorf("expected display name 'Hex.pm', got %q", c.DisplayName())
+	}
+}
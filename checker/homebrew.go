@@ -2,81 +2,245 @@ package checker
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"path"
 	"strings"
+	"sync"
 )
 
-// HomebrewChecker checks formula/cask name availability on Homebrew.
+// HomebrewChecker checks whether "brew install <name>" would already resolve
+// to something: a formula or cask, a homebrew-core alias, rename or tap
+// migration, or a third-party tap named <name>/homebrew-<name>.
 type HomebrewChecker struct {
-	client  *http.Client
-	baseURL string
+	client    *http.Client
+	baseURL   string
+	coreURL   string
+	githubURL string
+	token     string
 }
 
-func NewHomebrewChecker(client *http.Client, baseURL string) *HomebrewChecker {
-	return &HomebrewChecker{client: client, baseURL: baseURL}
+// NewHomebrewChecker creates a HomebrewChecker. baseURL serves the formulae.brew.sh
+// JSON API, coreURL serves raw files from the homebrew-core repository, and
+// githubURL is the GitHub API used to look for third-party taps.
+func NewHomebrewChecker(client *http.Client, baseURL, coreURL, githubURL, token string) *HomebrewChecker {
+	return &HomebrewChecker{client: client, baseURL: baseURL, coreURL: coreURL, githubURL: githubURL, token: token}
 }
 
 func (c *HomebrewChecker) Name() string        { return "homebrew" }
 func (c *HomebrewChecker) DisplayName() string { return "Homebrew" }
 
+// homebrewLookup returns a description of what name resolves to, "" if
+// nothing, or an error.
+type homebrewLookup func(ctx context.Context, name string) (string, error)
+
 func (c *HomebrewChecker) Check(ctx context.Context, name string) Result {
-	formulaExists, formulaErr := c.checkEndpoint(ctx, "/api/formula/"+url.PathEscape(name)+".json")
-	caskExists, caskErr := c.checkEndpoint(ctx, "/api/cask/"+url.PathEscape(name)+".json")
+	lookups := []homebrewLookup{
+		c.lookupFormula,
+		c.lookupCask,
+		c.lookupAlias,
+		c.lookupRename,
+		c.lookupTapMigration,
+		c.lookupTap,
+	}
+	tap := len(lookups) - 1
+
+	found := make([]string, len(lookups))
+	errs := make([]error, len(lookups))
+	var wg sync.WaitGroup
+	for i, lookup := range lookups {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			found[i], errs[i] = lookup(ctx, name)
+		}()
+	}
+	wg.Wait()
+
+	var matches []string
+	for _, f := range found {
+		if f != "" {
+			matches = append(matches, f)
+		}
+	}
 
-	// If both errored, report unknown.
-	if formulaErr != nil && caskErr != nil {
+	if len(matches) > 0 {
 		return Result{
 			Registry: c.DisplayName(),
 			Name:     name,
-			Status:   Unknown,
-			Err:      fmt.Errorf("formula: %v; cask: %v", formulaErr, caskErr),
+			Status:   Taken,
+			Detail:   strings.Join(matches, ", "),
 		}
 	}
 
-	var found []string
-	if formulaExists {
-		found = append(found, "formula")
-	}
-	if caskExists {
-		found = append(found, "cask")
+	// The tap lookup goes through the GitHub API, whose anonymous quota is
+	// shared with the github checker, so a failure there is only noted.
+	var note string
+	if err := errs[tap]; err != nil {
+		if inner := errors.Unwrap(err); inner != nil {
+			err = inner
+		}
+		note = "could not check for a " + name + "/homebrew-" + name + " tap: " + err.Error()
 	}
 
-	if len(found) > 0 {
+	// Any other failed lookup could be hiding a match, so nothing found is
+	// only "available" if every one of them succeeded.
+	var msgs []string
+	for i, err := range errs {
+		if err != nil && i != tap {
+			msgs = append(msgs, err.Error())
+		}
+	}
+	if len(msgs) > 0 {
 		return Result{
 			Registry: c.DisplayName(),
 			Name:     name,
-			Status:   Taken,
-			Detail:   strings.Join(found, ", "),
+			Status:   Unknown,
+			Err:      fmt.Errorf("%s", strings.Join(msgs, "; ")),
+			Detail:   note,
 		}
 	}
 
-	return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+	return Result{Registry: c.DisplayName(), Name: name, Status: Available, Detail: note}
+}
+
+func (c *HomebrewChecker) lookupFormula(ctx context.Context, name string) (string, error) {
+	exists, err := c.checkEndpoint(ctx, c.baseURL+"/api/formula/"+url.PathEscape(name)+".json")
+	if err != nil {
+		return "", fmt.Errorf("formula: %w", err)
+	}
+	if exists {
+		return "formula", nil
+	}
+	return "", nil
+}
+
+func (c *HomebrewChecker) lookupCask(ctx context.Context, name string) (string, error) {
+	exists, err := c.checkEndpoint(ctx, c.baseURL+"/api/cask/"+url.PathEscape(name)+".json")
+	if err != nil {
+		return "", fmt.Errorf("cask: %w", err)
+	}
+	if exists {
+		return "cask", nil
+	}
+	return "", nil
+}
+
+// lookupAlias fetches Aliases/<name> from homebrew-core. Aliases are symlinks,
+// so the raw file's content is the target path (e.g. "../Formula/p/python@3.12.rb").
+func (c *HomebrewChecker) lookupAlias(ctx context.Context, name string) (string, error) {
+	body, found, err := c.get(ctx, c.coreURL+"/Aliases/"+url.PathEscape(name), "")
+	if err != nil {
+		return "", fmt.Errorf("aliases: %w", err)
+	}
+	if !found {
+		return "", nil
+	}
+	target := strings.TrimSuffix(path.Base(strings.TrimSpace(string(body))), ".rb")
+	if target == "" || target == "." {
+		return "alias", nil
+	}
+	return "alias of " + target, nil
+}
+
+func (c *HomebrewChecker) lookupRename(ctx context.Context, name string) (string, error) {
+	renames, err := c.fetchCoreMap(ctx, "/formula_renames.json")
+	if err != nil {
+		return "", fmt.Errorf("formula_renames: %w", err)
+	}
+	if to, ok := renames[name]; ok {
+		return "renamed to " + to, nil
+	}
+	return "", nil
+}
+
+func (c *HomebrewChecker) lookupTapMigration(ctx context.Context, name string) (string, error) {
+	migrations, err := c.fetchCoreMap(ctx, "/tap_migrations.json")
+	if err != nil {
+		return "", fmt.Errorf("tap_migrations: %w", err)
+	}
+	if tap, ok := migrations[name]; ok {
+		return "migrated to " + tap, nil
+	}
+	return "", nil
+}
+
+// lookupTap looks for a GitHub repository <name>/homebrew-<name>, which
+// "brew tap <name>/<name>" would pick up.
+func (c *HomebrewChecker) lookupTap(ctx context.Context, name string) (string, error) {
+	repo := url.PathEscape(name) + "/homebrew-" + url.PathEscape(name)
+	_, found, err := c.get(ctx, c.githubURL+"/repos/"+repo, c.token)
+	if err != nil {
+		return "", fmt.Errorf("tap: %w", err)
+	}
+	if found {
+		return "tap " + name + "/homebrew-" + name, nil
+	}
+	return "", nil
+}
+
+// fetchCoreMap fetches a homebrew-core JSON file mapping names to strings.
+// A missing file is treated as an empty map.
+func (c *HomebrewChecker) fetchCoreMap(ctx context.Context, file string) (map[string]string, error) {
+	body, found, err := c.get(ctx, c.coreURL+file, "")
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, nil
+	}
+	var m map[string]string
+	if err := json.Unmarshal(body, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
 // checkEndpoint returns (exists, error).
-func (c *HomebrewChecker) checkEndpoint(ctx context.Context, path string) (bool, error) {
-	u := c.baseURL + path
+func (c *HomebrewChecker) checkEndpoint(ctx context.Context, u string) (bool, error) {
+	_, found, err := c.get(ctx, u, "")
+	return found, err
+}
 
+// get returns the body for 200, found=false for 404, and an error otherwise.
+func (c *HomebrewChecker) get(ctx context.Context, u, token string) ([]byte, bool, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return false, err
+		return nil, false, err
 	}
 	req.Header.Set("User-Agent", "nsprobe/1.0")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
 
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return false, err
+		return nil, false, err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	switch resp.StatusCode {
 	case http.StatusOK:
-		return true, nil
+		body, err := io.ReadAll(io.LimitReader(resp.Body, 4<<20))
+		if err != nil {
+			return nil, false, err
+		}
+		return body, true, nil
 	case http.StatusNotFound:
-		return false, nil
+		return nil, false, nil
+	case http.StatusTooManyRequests:
+		return nil, false, fmt.Errorf("rate limited")
+	case http.StatusForbidden:
+		// GitHub answers 403 once the anonymous quota is used up.
+		if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+			return nil, false, fmt.Errorf("rate limited")
+		}
+		return nil, false, fmt.Errorf("unexpected status: %d", resp.StatusCode)
 	default:
-		return false, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+		return nil, false, fmt.Errorf("unexpected status: %d", resp.StatusCode)
 	}
 }
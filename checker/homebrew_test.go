@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 )
 
@@ -18,7 +19,7 @@ func TestHomebrewChecker_TakenFormula(t *testing.T) {
 	}))
 	defer srv.Close()
 
-	c := NewHomebrewChecker(srv.Client(), srv.URL)
+	c := NewHomebrewChecker(srv.Client(), srv.URL, srv.URL, srv.URL, "")
 	result := c.Check(context.Background(), "wget")
 
 	if result.Status != Taken {
@@ -40,7 +41,7 @@ func TestHomebrewChecker_TakenCask(t *testing.T) {
 	}))
 	defer srv.Close()
 
-	c := NewHomebrewChecker(srv.Client(), srv.URL)
+	c := NewHomebrewChecker(srv.Client(), srv.URL, srv.URL, srv.URL, "")
 	result := c.Check(context.Background(), "firefox")
 
 	if result.Status != Taken {
@@ -54,12 +55,15 @@ func TestHomebrewChecker_TakenCask(t *testing.T) {
 func TestHomebrewChecker_TakenBoth(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Both formula and cask exist
-		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte(`{}`))
+		if r.URL.Path == "/api/formula/both.json" || r.URL.Path == "/api/cask/both.json" {
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
 	}))
 	defer srv.Close()
 
-	c := NewHomebrewChecker(srv.Client(), srv.URL)
+	c := NewHomebrewChecker(srv.Client(), srv.URL, srv.URL, srv.URL, "")
 	result := c.Check(context.Background(), "both")
 
 	if result.Status != Taken {
@@ -76,7 +80,7 @@ func TestHomebrewChecker_Available(t *testing.T) {
 	}))
 	defer srv.Close()
 
-	c := NewHomebrewChecker(srv.Client(), srv.URL)
+	c := NewHomebrewChecker(srv.Client(), srv.URL, srv.URL, srv.URL, "")
 	result := c.Check(context.Background(), "xyzzy-nonexistent")
 
 	if result.Status != Available {
@@ -90,7 +94,7 @@ func TestHomebrewChecker_ServerError(t *testing.T) {
 	}))
 	defer srv.Close()
 
-	c := NewHomebrewChecker(srv.Client(), srv.URL)
+	c := NewHomebrewChecker(srv.Client(), srv.URL, srv.URL, srv.URL, "")
 	result := c.Check(context.Background(), "test")
 
 	if result.Status != Unknown {
@@ -99,7 +103,7 @@ func TestHomebrewChecker_ServerError(t *testing.T) {
 }
 
 func TestHomebrewChecker_Name(t *testing.T) {
-	c := NewHomebrewChecker(http.DefaultClient, "")
+	c := NewHomebrewChecker(http.DefaultClient, "", "", "", "")
 	if c.Name() != "homebrew" {
 		t.Errorf("expected name 'homebrew', got %q", c.Name())
 	}
@@ -109,32 +113,133 @@ func TestHomebrewChecker_Name(t *testing.T) {
 }
 
 func TestHomebrewChecker_URLPaths(t *testing.T) {
+	var mu sync.Mutex
 	var paths []string
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
 		paths = append(paths, r.URL.Path)
+		mu.Unlock()
 		w.WriteHeader(http.StatusNotFound)
 	}))
 	defer srv.Close()
 
-	c := NewHomebrewChecker(srv.Client(), srv.URL)
+	c := NewHomebrewChecker(srv.Client(), srv.URL, srv.URL, srv.URL, "")
 	c.Check(context.Background(), "myproject")
 
-	expectedFormula := "/api/formula/myproject.json"
-	expectedCask := "/api/cask/myproject.json"
-
-	foundFormula, foundCask := false, false
+	seen := make(map[string]bool)
 	for _, p := range paths {
-		if p == expectedFormula {
-			foundFormula = true
+		seen[p] = true
+	}
+	for _, want := range []string{
+		"/api/formula/myproject.json",
+		"/api/cask/myproject.json",
+		"/Aliases/myproject",
+		"/formula_renames.json",
+		"/tap_migrations.json",
+		"/repos/myproject/homebrew-myproject",
+	} {
+		if !seen[want] {
+			t.Errorf("expected path %q in requests, got %v", want, paths)
+		}
+	}
+}
+
+func TestHomebrewChecker_AliasRenameAndMigration(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/Aliases/python":
+			_, _ = w.Write([]byte("../Formula/p/python@3.13.rb"))
+		case "/formula_renames.json":
+			_, _ = w.Write([]byte(`{"python":"python@3.13","other":"thing"}`))
+		case "/tap_migrations.json":
+			_, _ = w.Write([]byte(`{"python":"homebrew/cask"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewHomebrewChecker(srv.Client(), srv.URL, srv.URL, srv.URL, "")
+	result := c.Check(context.Background(), "python")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	want := "alias of python@3.13, renamed to python@3.13, migrated to homebrew/cask"
+	if result.Detail != want {
+		t.Errorf("expected detail %q, got %q", want, result.Detail)
+	}
+}
+
+func TestHomebrewChecker_ThirdPartyTap(t *testing.T) {
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/goreleaser/homebrew-goreleaser":
+			auth = r.Header.Get("Authorization")
+			_, _ = w.Write([]byte(`{"full_name":"goreleaser/homebrew-goreleaser"}`))
+		case "/formula_renames.json", "/tap_migrations.json":
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
 		}
-		if p == expectedCask {
-			foundCask = true
+	}))
+	defer srv.Close()
+
+	c := NewHomebrewChecker(srv.Client(), srv.URL, srv.URL, srv.URL, "test-token")
+	result := c.Check(context.Background(), "goreleaser")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	if result.Detail != "tap goreleaser/homebrew-goreleaser" {
+		t.Errorf("expected detail 'tap goreleaser/homebrew-goreleaser', got %q", result.Detail)
+	}
+	if auth != "Bearer test-token" {
+		t.Errorf("expected 'Bearer test-token', got %q", auth)
+	}
+}
+
+func TestHomebrewChecker_PartialFailureIsUnknown(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/cask/quiet.json" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
 		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewHomebrewChecker(srv.Client(), srv.URL, srv.URL, srv.URL, "")
+	result := c.Check(context.Background(), "quiet")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil || result.Err.Error() != "cask: unexpected status: 500" {
+		t.Errorf("expected the failed cask lookup in the error, got %v", result.Err)
 	}
-	if !foundFormula {
-		t.Errorf("expected formula path %q in requests, got %v", expectedFormula, paths)
+}
+
+func TestHomebrewChecker_TapRateLimitedStillAvailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/repos/quiet/homebrew-quiet" {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewHomebrewChecker(srv.Client(), srv.URL, srv.URL, srv.URL, "")
+	result := c.Check(context.Background(), "quiet")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v (err: %v)", result.Status, result.Err)
 	}
-	if !foundCask {
-		t.Errorf("expected cask path %q in requests, got %v", expectedCask, paths)
+	want := "could not check for a quiet/homebrew-quiet tap: rate limited"
+	if result.Detail != want {
+		t.Errorf("expected detail %q, got %q", want, result.Detail)
 	}
 }
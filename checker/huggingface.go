@@ -0,0 +1,173 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// huggingFaceTopN is how many exact-name repositories are reported per kind.
+const huggingFaceTopN = 3
+
+// HuggingFaceChecker checks handle availability on the Hugging Face Hub, and
+// lists models, datasets or Spaces named <name> under other owners. Those
+// repositories don't take the handle, so they only ever show up in Detail.
+type HuggingFaceChecker struct {
+	client  *http.Client
+	baseURL string
+	token   string
+}
+
+func NewHuggingFaceChecker(client *http.Client, baseURL string, token string) *HuggingFaceChecker {
+	return &HuggingFaceChecker{client: client, baseURL: baseURL, token: token}
+}
+
+func (c *HuggingFaceChecker) Name() string        { return "huggingface" }
+func (c *HuggingFaceChecker) DisplayName() string { return "Hugging Face" }
+
+// huggingFaceRepoKinds maps each searchable API collection to its label.
+var huggingFaceRepoKinds = []struct {
+	path  string
+	label string
+}{
+	{"/api/models", "model"},
+	{"/api/datasets", "dataset"},
+	{"/api/spaces", "space"},
+}
+
+func (c *HuggingFaceChecker) Check(ctx context.Context, name string) Result {
+	handles := []struct {
+		path  string
+		label string
+	}{
+		{"/api/users/" + url.PathEscape(name) + "/overview", "user"},
+		{"/api/organizations/" + url.PathEscape(name) + "/overview", "organization"},
+	}
+
+	n := len(handles) + len(huggingFaceRepoKinds)
+	found := make([][]string, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i, h := range handles {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			exists, err := c.getJSON(ctx, h.path, nil)
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", h.label, err)
+			} else if exists {
+				found[i] = []string{h.label + " " + name}
+			}
+		}()
+	}
+	for j, kind := range huggingFaceRepoKinds {
+		i := len(handles) + j
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			found[i], errs[i] = c.searchRepos(ctx, kind.path, kind.label, name)
+			if errs[i] != nil {
+				errs[i] = fmt.Errorf("%ss: %w", kind.label, errs[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	var lines []string
+	for _, f := range found {
+		lines = append(lines, f...)
+	}
+	// A failed repository search only loses detail, not the handle status.
+	if err := joinErrors(errs[len(handles):]...); err != nil {
+		lines = append(lines, "could not finish the repository search ("+err.Error()+")")
+	}
+	detail := strings.Join(lines, "\n")
+
+	for _, f := range found[:len(handles)] {
+		if len(f) > 0 {
+			return Result{Registry: c.DisplayName(), Name: name, Status: Taken, Detail: detail}
+		}
+	}
+
+	if err := joinErrors(errs[:len(handles)]...); err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err, Detail: detail}
+	}
+
+	return Result{Registry: c.DisplayName(), Name: name, Status: Available, Detail: detail}
+}
+
+// searchRepos returns the most-liked repositories of one kind whose name
+// (the part after the owner) is exactly name.
+func (c *HuggingFaceChecker) searchRepos(ctx context.Context, path, label, name string) ([]string, error) {
+	q := url.Values{}
+	q.Set("search", name)
+	q.Set("sort", "likes")
+	q.Set("direction", "-1")
+	q.Set("limit", "100")
+
+	var repos []struct {
+		ID    string `json:"id"`
+		Likes int64  `json:"likes"`
+	}
+	if _, err := c.getJSON(ctx, path+"?"+q.Encode(), &repos); err != nil {
+		return nil, err
+	}
+
+	var lines []string
+	for _, r := range repos {
+		_, repoName, ok := strings.Cut(r.ID, "/")
+		if !ok || !strings.EqualFold(repoName, name) {
+			continue
+		}
+		likes := formatCount(r.Likes) + " likes"
+		if r.Likes == 1 {
+			likes = "1 like"
+		}
+		lines = append(lines, fmt.Sprintf("%s %s (%s)", label, r.ID, likes))
+		if len(lines) == huggingFaceTopN {
+			break
+		}
+	}
+	return lines, nil
+}
+
+// getJSON fetches path and, if v is non-nil, decodes the response into it.
+// It returns false for 404.
+func (c *HuggingFaceChecker) getJSON(ctx context.Context, path string, v any) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if v == nil {
+			return true, nil
+		}
+		if err := json.NewDecoder(io.LimitReader(resp.Body, 4<<20)).Decode(v); err != nil {
+			return false, err
+		}
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	case http.StatusTooManyRequests:
+		return false, fmt.Errorf("rate limited")
+	default:
+		return false, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,183 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestHuggingFaceChecker_TakenOrganization(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/organizations/mistralai/overview":
+			_, _ = w.Write([]byte(`{"name":"mistralai","fullname":"Mistral AI"}`))
+		case "/api/models", "/api/datasets", "/api/spaces":
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewHuggingFaceChecker(srv.Client(), srv.URL, "")
+	result := c.Check(context.Background(), "mistralai")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	if result.Detail != "organization mistralai" {
+		t.Errorf("expected detail 'organization mistralai', got %q", result.Detail)
+	}
+}
+
+func TestHuggingFaceChecker_ReposDoNotTakeHandle(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/models":
+			_, _ = w.Write([]byte(`[` +
+				`{"id":"big-lab/whisper","likes":4200},` +
+				`{"id":"big-lab/whisper-large","likes":9000},` +
+				`{"id":"someone/Whisper","likes":1},` +
+				`{"id":"a/whisper","likes":0},` +
+				`{"id":"b/whisper","likes":0}]`))
+		case "/api/spaces":
+			_, _ = w.Write([]byte(`[{"id":"demo/whisper","likes":12}]`))
+		case "/api/datasets":
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewHuggingFaceChecker(srv.Client(), srv.URL, "")
+	result := c.Check(context.Background(), "whisper")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v (err: %v)", result.Status, result.Err)
+	}
+	want := "model big-lab/whisper (4,200 likes)\n" +
+		"model someone/Whisper (1 like)\n" +
+		"model a/whisper (0 likes)\n" +
+		"space demo/whisper (12 likes)"
+	if result.Detail != want {
+		t.Errorf("expected detail %q, got %q", want, result.Detail)
+	}
+}
+
+func TestHuggingFaceChecker_Available(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/models", "/api/datasets", "/api/spaces":
+			_, _ = w.Write([]byte(`[{"id":"x/xyzzy-nonexistent-v2","likes":3}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewHuggingFaceChecker(srv.Client(), srv.URL, "")
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v (err: %v)", result.Status, result.Err)
+	}
+}
+
+func TestHuggingFaceChecker_TakenHandleAndRepos(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/users/julien/overview":
+			_, _ = w.Write([]byte(`{"user":"julien"}`))
+		case "/api/models":
+			_, _ = w.Write([]byte(`[{"id":"someone/julien","likes":2}]`))
+		case "/api/datasets":
+			w.WriteHeader(http.StatusTooManyRequests)
+		case "/api/spaces":
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewHuggingFaceChecker(srv.Client(), srv.URL, "")
+	result := c.Check(context.Background(), "julien")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	want := "user julien\n" +
+		"model someone/julien (2 likes)\n" +
+		"could not finish the repository search (datasets: rate limited)"
+	if result.Detail != want {
+		t.Errorf("expected detail %q, got %q", want, result.Detail)
+	}
+}
+
+func TestHuggingFaceChecker_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewHuggingFaceChecker(srv.Client(), srv.URL, "")
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestHuggingFaceChecker_Requests(t *testing.T) {
+	var mu sync.Mutex
+	auth := make(map[string]string)
+	searches := make(map[string]string)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		auth[r.URL.Path] = r.Header.Get("Authorization")
+		if q := r.URL.Query(); q.Get("search") != "" {
+			searches[r.URL.Path] = q.Get("search") + "," + q.Get("sort")
+		}
+		mu.Unlock()
+		if r.URL.Query().Get("search") != "" {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewHuggingFaceChecker(srv.Client(), srv.URL, "hf_test")
+	c.Check(context.Background(), "myproject")
+
+	for _, path := range []string{
+		"/api/users/myproject/overview",
+		"/api/organizations/myproject/overview",
+		"/api/models", "/api/datasets", "/api/spaces",
+	} {
+		if auth[path] != "Bearer hf_test" {
+			t.Errorf("%s: expected 'Bearer hf_test', got %q", path, auth[path])
+		}
+	}
+	for _, path := range []string{"/api/models", "/api/datasets", "/api/spaces"} {
+		if searches[path] != "myproject,likes" {
+			t.Errorf("%s: expected search=myproject&sort=likes, got %q", path, searches[path])
+		}
+	}
+}
+
+func TestHuggingFaceChecker_Name(t *testing.T) {
+	c := NewHuggingFaceChecker(http.DefaultClient, "", "")
+	if c.Name() != "huggingface" {
+		t.Errorf("expected name 'huggingface', got %q", c.Name())
+	}
+	if c.DisplayName() != "Hugging Face" {
+		t.Errorf("expected display name 'Hugging Face', got %q", c.DisplayName())
+	}
+}
@@ -0,0 +1,140 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// mavenMaxArtifacts caps how many colliding artifacts are listed individually.
+const mavenMaxArtifacts = 5
+
+// MavenChecker checks artifactId and reverse-DNS groupId usage on Maven Central.
+type MavenChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewMavenChecker(client *http.Client, baseURL string) *MavenChecker {
+	return &MavenChecker{client: client, baseURL: baseURL}
+}
+
+func (c *MavenChecker) Name() string        { return "maven" }
+func (c *MavenChecker) DisplayName() string { return "Maven Central" }
+
+type mavenSearch struct {
+	NumFound int `json:"numFound"`
+	Docs     []struct {
+		GroupID    string `json:"g"`
+		ArtifactID string `json:"a"`
+	} `json:"docs"`
+}
+
+func (c *MavenChecker) Check(ctx context.Context, name string) Result {
+	groups := []string{"io." + name, "com." + name, "dev." + name}
+
+	// The artifactId query goes first, followed by one query per groupId.
+	queries := []string{"a:" + solrPhrase(name)}
+	for _, g := range groups {
+		queries = append(queries, "g:"+solrPhrase(g))
+	}
+
+	results := make([]mavenSearch, len(queries))
+	errs := make([]error, len(queries))
+	var wg sync.WaitGroup
+	for i, q := range queries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rows := 0
+			if i == 0 {
+				rows = mavenMaxArtifacts
+			}
+			results[i], errs[i] = c.search(ctx, q, rows)
+		}()
+	}
+	wg.Wait()
+
+	var lines []string
+	artifacts := results[0]
+	for _, doc := range artifacts.Docs {
+		lines = append(lines, "artifact "+doc.GroupID+":"+doc.ArtifactID)
+	}
+	if more := artifacts.NumFound - len(artifacts.Docs); more > 0 {
+		lines = append(lines, fmt.Sprintf("…and %d more artifacts named %s", more, name))
+	}
+	for i, g := range groups {
+		if n := results[i+1].NumFound; n > 0 {
+			lines = append(lines, fmt.Sprintf("groupId %s (%s)", g, pluralize(n, "artifact")))
+		}
+	}
+
+	if len(lines) > 0 {
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Taken,
+			Detail:   strings.Join(lines, "\n"),
+		}
+	}
+
+	if err := joinErrors(errs...); err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+
+	return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+}
+
+// solrPhraseEscaper escapes the characters that are special inside a Solr
+// phrase query.
+var solrPhraseEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// solrPhrase quotes s as a Solr phrase query term.
+func solrPhrase(s string) string {
+	return `"` + solrPhraseEscaper.Replace(s) + `"`
+}
+
+// pluralize returns e.g. "1 artifact" or "3 artifacts".
+func pluralize(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+func (c *MavenChecker) search(ctx context.Context, query string, rows int) (mavenSearch, error) {
+	params := url.Values{}
+	params.Set("q", query)
+	params.Set("rows", fmt.Sprint(rows))
+	params.Set("wt", "json")
+	u := c.baseURL + "/solrsearch/select?" + params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return mavenSearch{}, err
+	}
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return mavenSearch{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return mavenSearch{}, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+
+	var data struct {
+		Response mavenSearch `json:"response"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 65536)).Decode(&data); err != nil {
+		return mavenSearch{}, err
+	}
+	return data.Response, nil
+}
@@ -0,0 +1,150 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestMavenChecker_TakenArtifactAndGroup(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("q") {
+		case `a:"netty"`:
+			_, _ = w.Write([]byte(`{"response":{"numFound":7,"docs":[{"g":"io.netty","a":"netty"},{"g":"org.jboss.netty","a":"netty"}]}}`))
+		case `g:"io.netty"`:
+			_, _ = w.Write([]byte(`{"response":{"numFound":120,"docs":[]}}`))
+		default:
+			_, _ = w.Write([]byte(`{"response":{"numFound":0,"docs":[]}}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := NewMavenChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "netty")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	want := strings.Join([]string{
+		"artifact io.netty:netty",
+		"artifact org.jboss.netty:netty",
+		"…and 5 more artifacts named netty",
+		"groupId io.netty (120 artifacts)",
+	}, "\n")
+	if result.Detail != want {
+		t.Errorf("expected detail:\n%s\ngot:\n%s", want, result.Detail)
+	}
+}
+
+func TestMavenChecker_TakenGroupOnly(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("q") == `g:"dev.myproject"` {
+			_, _ = w.Write([]byte(`{"response":{"numFound":1,"docs":[]}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"response":{"numFound":0,"docs":[]}}`))
+	}))
+	defer srv.Close()
+
+	c := NewMavenChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "myproject")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "groupId dev.myproject (1 artifact)" {
+		t.Errorf("expected groupId detail, got %q", result.Detail)
+	}
+}
+
+func TestMavenChecker_Available(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"response":{"numFound":0,"docs":[]}}`))
+	}))
+	defer srv.Close()
+
+	c := NewMavenChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+}
+
+func TestMavenChecker_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	c := NewMavenChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestMavenChecker_Queries(t *testing.T) {
+	var mu sync.Mutex
+	queries := map[string]string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		queries[r.URL.Query().Get("q")] = r.URL.Path
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"response":{"numFound":0,"docs":[]}}`))
+	}))
+	defer srv.Close()
+
+	c := NewMavenChecker(srv.Client(), srv.URL)
+	c.Check(context.Background(), "myproject")
+
+	for _, q := range []string{`a:"myproject"`, `g:"io.myproject"`, `g:"com.myproject"`, `g:"dev.myproject"`} {
+		path, ok := queries[q]
+		if !ok {
+			t.Errorf("expected query %s, got %v", q, queries)
+			continue
+		}
+		if path != "/solrsearch/select" {
+			t.Errorf("expected path '/solrsearch/select' for %s, got %q", q, path)
+		}
+	}
+}
+
+func TestMavenChecker_QueryEscaping(t *testing.T) {
+	var mu sync.Mutex
+	queries := map[string]bool{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		queries[r.URL.Query().Get("q")] = true
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"response":{"numFound":0,"docs":[]}}`))
+	}))
+	defer srv.Close()
+
+	c := NewMavenChecker(srv.Client(), srv.URL)
+	// Solr only needs quotes and backslashes escaped; a tab stays as is.
+	c.Check(context.Background(), `my"tool\`+"\t")
+
+	for _, q := range []string{`a:"my\"tool\\` + "\t" + `"`, `g:"io.my\"tool\\` + "\t" + `"`} {
+		if !queries[q] {
+			t.Errorf("expected query %s, got %v", q, queries)
+		}
+	}
+}
+
+func TestMavenChecker_Name(t *testing.T) {
+	c := NewMavenChecker(http.DefaultClient, "")
+	if c.Name() != "maven" {
+		t.Errorf("expected name 'maven', got %q", c.Name())
+	}
+	if c.DisplayName() != "Maven Central" {
+		t.Errorf("expected display name 'Maven Central', got %q", c.DisplayName())
+	}
+}
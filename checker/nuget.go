@@ -0,0 +1,235 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// NuGetChecker checks package ID availability on a NuGet v3 feed, including
+// whether the ID falls under someone's reserved ID prefix.
+type NuGetChecker struct {
+	client   *http.Client
+	indexURL string
+}
+
+// NewNuGetChecker creates a NuGetChecker for the feed whose service index
+// lives at indexURL (e.g. https://api.nuget.org/v3/index.json).
+func NewNuGetChecker(client *http.Client, indexURL string) *NuGetChecker {
+	return &NuGetChecker{client: client, indexURL: indexURL}
+}
+
+func (c *NuGetChecker) Name() string        { return "nuget" }
+func (c *NuGetChecker) DisplayName() string { return "NuGet" }
+
+func (c *NuGetChecker) Check(ctx context.Context, name string) Result {
+	registrationsURL, searchURL, err := c.resolveResources(ctx)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+
+	// Package IDs are case-insensitive; registration URLs use the lowercase ID.
+	id := strings.ToLower(name)
+	exists, err := c.checkRegistration(ctx, registrationsURL+url.PathEscape(id)+"/index.json")
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	if exists {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Taken}
+	}
+
+	verified, err := c.findVerifiedPackages(ctx, searchURL, name)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	if len(verified) > 0 {
+		pkg := verified[0]
+		prefix := nugetPrefixOf(pkg.ID, name)
+		if !nugetPrefixReserved(name, verified) {
+			// A verified <name>.X.Y could just as well come from a narrower
+			// <name>.X.* reservation.
+			return Result{
+				Registry: c.DisplayName(),
+				Name:     name,
+				Status:   Available,
+				Detail:   "verified package " + pkg.ID + " hints that " + prefix + ".* may be a reserved prefix",
+			}
+		}
+		detail := "prefix " + prefix + ".* is reserved"
+		if len(pkg.Owners) > 0 {
+			detail += " by " + strings.Join(pkg.Owners, ", ")
+		}
+		return Result{Registry: c.DisplayName(), Name: name, Status: Reserved, Detail: detail}
+	}
+
+	return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+}
+
+// resolveResources reads the service index and returns the registration base
+// URL (with a trailing slash) and the search endpoint.
+func (c *NuGetChecker) resolveResources(ctx context.Context) (string, string, error) {
+	body, err := c.getJSON(ctx, c.indexURL)
+	if err != nil {
+		return "", "", fmt.Errorf("service index: %w", err)
+	}
+	defer func() { _ = body.Close() }()
+
+	var index struct {
+		Resources []struct {
+			ID   string `json:"@id"`
+			Type string `json:"@type"`
+		} `json:"resources"`
+	}
+	if err := json.NewDecoder(io.LimitReader(body, 1<<20)).Decode(&index); err != nil {
+		return "", "", fmt.Errorf("service index: %w", err)
+	}
+
+	var registrations, search string
+	for _, r := range index.Resources {
+		switch {
+		case registrations == "" && strings.HasPrefix(r.Type, "RegistrationsBaseUrl"):
+			registrations = r.ID
+		case search == "" && strings.HasPrefix(r.Type, "SearchQueryService"):
+			search = r.ID
+		}
+	}
+	if registrations == "" || search == "" {
+		return "", "", fmt.Errorf("service index: missing registration or search resource")
+	}
+	if !strings.HasSuffix(registrations, "/") {
+		registrations += "/"
+	}
+	return registrations, search, nil
+}
+
+func (c *NuGetChecker) checkRegistration(ctx context.Context, u string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+}
+
+// nugetPackage is a search hit for a verified package.
+type nugetPackage struct {
+	ID     string
+	Owners []string
+}
+
+// findVerifiedPackages looks for verified packages whose IDs are name or
+// start with "<name>.". NuGet marks a package verified only when its ID
+// matches a prefix reserved by its owner.
+func (c *NuGetChecker) findVerifiedPackages(ctx context.Context, searchURL, name string) ([]nugetPackage, error) {
+	params := url.Values{}
+	params.Set("q", name)
+	params.Set("prerelease", "true")
+	params.Set("semVerLevel", "2.0.0")
+	params.Set("take", "50")
+
+	body, err := c.getJSON(ctx, searchURL+"?"+params.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+	defer func() { _ = body.Close() }()
+
+	var data struct {
+		Data []struct {
+			ID       string          `json:"id"`
+			Verified bool            `json:"verified"`
+			Owners   json.RawMessage `json:"owners"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(io.LimitReader(body, 1<<20)).Decode(&data); err != nil {
+		return nil, fmt.Errorf("search: %w", err)
+	}
+
+	lower := strings.ToLower(name)
+	var verified []nugetPackage
+	for _, pkg := range data.Data {
+		id := strings.ToLower(pkg.ID)
+		if pkg.Verified && (id == lower || strings.HasPrefix(id, lower+".")) {
+			verified = append(verified, nugetPackage{ID: pkg.ID, Owners: parseNuGetOwners(pkg.Owners)})
+		}
+	}
+	return verified, nil
+}
+
+// nugetPrefixReserved reports whether the verified packages show that the
+// reserved prefix is name itself: either name is verified, or packages from
+// at least two different <name>.X branches are.
+func nugetPrefixReserved(name string, verified []nugetPackage) bool {
+	lower := strings.ToLower(name)
+	branches := make(map[string]bool)
+	for _, pkg := range verified {
+		id := strings.ToLower(pkg.ID)
+		if id == lower {
+			return true
+		}
+		branch, _, _ := strings.Cut(id[len(lower)+1:], ".")
+		branches[branch] = true
+	}
+	return len(branches) > 1
+}
+
+// nugetPrefixOf returns the leading segments of id that correspond to name,
+// keeping id's own casing (e.g. "Serilog" for "Serilog.Sinks.File").
+func nugetPrefixOf(id, name string) string {
+	n := strings.Count(name, ".") + 1
+	parts := strings.SplitN(id, ".", n+1)
+	if len(parts) < n {
+		return name
+	}
+	return strings.Join(parts[:n], ".")
+}
+
+// parseNuGetOwners accepts both forms the search API uses for "owners":
+// a single string or an array of strings.
+func parseNuGetOwners(raw json.RawMessage) []string {
+	var many []string
+	if err := json.Unmarshal(raw, &many); err == nil {
+		return many
+	}
+	var one string
+	if err := json.Unmarshal(raw, &one); err == nil && one != "" {
+		return []string{one}
+	}
+	return nil
+}
+
+func (c *NuGetChecker) getJSON(ctx context.Context, u string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,186 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newNuGetServer serves a service index pointing back at itself, delegating
+// registration and search requests to the given handlers.
+func newNuGetServer(registration, search http.HandlerFunc) *httptest.Server {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v3/index.json":
+			_, _ = w.Write([]byte(`{"version":"3.0.0","resources":[` +
+				`{"@id":"` + srv.URL + `/query","@type":"SearchQueryService"},` +
+				`{"@id":"` + srv.URL + `/registration5-gz-semver2","@type":"RegistrationsBaseUrl/3.6.0"}]}`))
+		case r.URL.Path == "/query":
+			search(w, r)
+		default:
+			registration(w, r)
+		}
+	}))
+	return srv
+}
+
+func emptyNuGetSearch(w http.ResponseWriter, r *http.Request) {
+	_, _ = w.Write([]byte(`{"totalHits":0,"data":[]}`))
+}
+
+func TestNuGetChecker_Taken(t *testing.T) {
+	var receivedPath string
+	srv := newNuGetServer(func(w http.ResponseWriter, r *http.Request) {
+		receivedPath = r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	}, emptyNuGetSearch)
+	defer srv.Close()
+
+	c := NewNuGetChecker(srv.Client(), srv.URL+"/v3/index.json")
+	result := c.Check(context.Background(), "Newtonsoft.Json")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if receivedPath != "/registration5-gz-semver2/newtonsoft.json/index.json" {
+		t.Errorf("expected lowercase registration path, got %q", receivedPath)
+	}
+}
+
+func TestNuGetChecker_ReservedPrefix(t *testing.T) {
+	srv := newNuGetServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"totalHits":3,"data":[` +
+			`{"id":"Serilogger","verified":false,"owners":"someone"},` +
+			`{"id":"Serilog.Sinks.File","verified":true,"owners":["serilog"]},` +
+			`{"id":"Serilog.Extensions.Logging","verified":true,"owners":["serilog"]}]}`))
+	})
+	defer srv.Close()
+
+	c := NewNuGetChecker(srv.Client(), srv.URL+"/v3/index.json")
+	result := c.Check(context.Background(), "serilog")
+
+	if result.Status != Reserved {
+		t.Errorf("expected Reserved, got %v", result.Status)
+	}
+	if result.Detail != "prefix Serilog.* is reserved by serilog" {
+		t.Errorf("expected reserved prefix detail, got %q", result.Detail)
+	}
+}
+
+func TestNuGetChecker_NarrowerPrefixIsOnlyAHint(t *testing.T) {
+	srv := newNuGetServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"totalHits":1,"data":[{"id":"Foo.Bar.Baz","verified":true,"owners":["bar"]}]}`))
+	})
+	defer srv.Close()
+
+	c := NewNuGetChecker(srv.Client(), srv.URL+"/v3/index.json")
+	result := c.Check(context.Background(), "foo")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+	if result.Detail != "verified package Foo.Bar.Baz hints that Foo.* may be a reserved prefix" {
+		t.Errorf("expected a hint, got %q", result.Detail)
+	}
+}
+
+func TestNuGetChecker_CaseFoldingChangesLength(t *testing.T) {
+	srv := newNuGetServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"totalHits":2,"data":[` +
+			`{"id":"KK.A","verified":true,"owners":["kk"]},` +
+			`{"id":"KK.B","verified":true,"owners":["kk"]}]}`))
+	})
+	defer srv.Close()
+
+	// U+212A KELVIN SIGN lowercases to the one-byte "k".
+	c := NewNuGetChecker(srv.Client(), srv.URL+"/v3/index.json")
+	result := c.Check(context.Background(), "\u212a\u212a")
+
+	if result.Status != Reserved {
+		t.Errorf("expected Reserved, got %v", result.Status)
+	}
+	if result.Detail != "prefix KK.* is reserved by kk" {
+		t.Errorf("expected reserved prefix detail, got %q", result.Detail)
+	}
+}
+
+func TestNuGetChecker_UnverifiedPrefixIsAvailable(t *testing.T) {
+	srv := newNuGetServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"totalHits":1,"data":[{"id":"MyProject.Core","verified":false,"owners":"someone"}]}`))
+	})
+	defer srv.Close()
+
+	c := NewNuGetChecker(srv.Client(), srv.URL+"/v3/index.json")
+	result := c.Check(context.Background(), "MyProject")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+}
+
+func TestNuGetChecker_Available(t *testing.T) {
+	srv := newNuGetServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}, emptyNuGetSearch)
+	defer srv.Close()
+
+	c := NewNuGetChecker(srv.Client(), srv.URL+"/v3/index.json")
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+}
+
+func TestNuGetChecker_ServiceIndexError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewNuGetChecker(srv.Client(), srv.URL+"/v3/index.json")
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestNuGetChecker_SearchError(t *testing.T) {
+	srv := newNuGetServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer srv.Close()
+
+	c := NewNuGetChecker(srv.Client(), srv.URL+"/v3/index.json")
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown when prefix search fails, got %v", result.Status)
+	}
+}
+
+func TestNuGetChecker_Name(t *testing.T) {
+	c := NewNuGetChecker(http.DefaultClient, "")
+	if c.Name() != "nuget" {
+		t.Errorf("expected name 'nuget', got %q", c.Name())
+	}
+	if c.DisplayName() != "NuGet" {
+		t.Errorf("expected display name 'NuGet', got %q", c.DisplayName())
+	}
+}
@@ -0,0 +1,223 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// OCIChecker checks repository availability on any registry speaking the OCI
+// Distribution v2 protocol (GHCR, Quay, Harbor, self-hosted, ...).
+type OCIChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+// NewOCIChecker creates an OCIChecker for the registry at baseURL
+// (e.g. https://ghcr.io).
+func NewOCIChecker(client *http.Client, baseURL string) *OCIChecker {
+	return &OCIChecker{client: client, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+func (c *OCIChecker) Name() string { return "oci" }
+
+func (c *OCIChecker) DisplayName() string {
+	host := c.baseURL
+	if u, err := url.Parse(c.baseURL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	return "OCI (" + host + ")"
+}
+
+// ociComponentPattern is a repository path component as allowed by the
+// distribution spec.
+var ociComponentPattern = regexp.MustCompile(`^[a-z0-9]+((\.|_|__|-+)[a-z0-9]+)*$`)
+
+func (c *OCIChecker) Check(ctx context.Context, name string) Result {
+	// Repository names must be lowercase in the distribution spec.
+	lower := strings.ToLower(name)
+	if !ociComponentPattern.MatchString(lower) {
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Invalid,
+			Detail:   "not a valid repository name (a-z and 0-9, separated by ., _, __ or -)",
+		}
+	}
+	repos := []string{lower + "/" + lower, lower}
+
+	tagCounts := make([]int, len(repos))
+	errs := make([]error, len(repos))
+	var wg sync.WaitGroup
+	for i, repo := range repos {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tagCounts[i], errs[i] = c.countTags(ctx, repo)
+			if errs[i] != nil {
+				errs[i] = fmt.Errorf("%s: %w", repo, errs[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	var found []string
+	for i, repo := range repos {
+		if tagCounts[i] > 0 {
+			found = append(found, fmt.Sprintf("%s (%s)", repo, pluralize(tagCounts[i], "tag")))
+		}
+	}
+
+	if len(found) > 0 {
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Taken,
+			Detail:   strings.Join(found, ", "),
+		}
+	}
+
+	if err := joinErrors(errs...); err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+
+	return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+}
+
+// countTags lists the tags of repo. A repository that doesn't exist has no
+// tags. Anonymous pulls usually need a bearer token first: the registry
+// answers 401 with a WWW-Authenticate challenge naming the token service.
+func (c *OCIChecker) countTags(ctx context.Context, repo string) (int, error) {
+	u := c.baseURL + "/v2/" + repo + "/tags/list"
+
+	resp, err := c.get(ctx, u, "")
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		_ = resp.Body.Close()
+
+		token, err := c.fetchToken(ctx, challenge, repo)
+		if err != nil {
+			return 0, err
+		}
+		if resp, err = c.get(ctx, u, token); err != nil {
+			return 0, err
+		}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		var data struct {
+			Tags []string `json:"tags"`
+		}
+		if err := json.NewDecoder(io.LimitReader(resp.Body, 4<<20)).Decode(&data); err != nil {
+			return 0, err
+		}
+		return len(data.Tags), nil
+	case http.StatusNotFound:
+		return 0, nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return 0, fmt.Errorf("access denied (status %d)", resp.StatusCode)
+	case http.StatusTooManyRequests:
+		return 0, fmt.Errorf("rate limited")
+	default:
+		return 0, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+}
+
+// fetchToken performs the bearer token exchange described by challenge.
+func (c *OCIChecker) fetchToken(ctx context.Context, challenge, repo string) (string, error) {
+	scheme, params := parseAuthChallenge(challenge)
+	if !strings.EqualFold(scheme, "Bearer") || params["realm"] == "" {
+		return "", fmt.Errorf("unsupported auth challenge %q", challenge)
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil {
+		return "", fmt.Errorf("token realm: %w", err)
+	}
+	q := realm.Query()
+	if params["service"] != "" {
+		q.Set("service", params["service"])
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = "repository:" + repo + ":pull"
+	}
+	q.Set("scope", scope)
+	realm.RawQuery = q.Encode()
+
+	resp, err := c.get(ctx, realm.String(), "")
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token: unexpected status: %d", resp.StatusCode)
+	}
+
+	var data struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 65536)).Decode(&data); err != nil {
+		return "", fmt.Errorf("token: %w", err)
+	}
+	if data.Token != "" {
+		return data.Token, nil
+	}
+	if data.AccessToken != "" {
+		return data.AccessToken, nil
+	}
+	return "", fmt.Errorf("token: empty response")
+}
+
+func (c *OCIChecker) get(ctx context.Context, u, token string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return c.client.Do(req)
+}
+
+// parseAuthChallenge splits a WWW-Authenticate header such as
+// `Bearer realm="https://ghcr.io/token",service="ghcr.io"` into its scheme
+// and parameters. Quoted values may contain commas.
+func parseAuthChallenge(header string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+	params := make(map[string]string)
+	for rest = strings.TrimSpace(rest); rest != ""; rest = strings.TrimLeft(rest, ", ") {
+		key, after, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		var value string
+		if strings.HasPrefix(after, `"`) {
+			end := strings.Index(after[1:], `"`)
+			if end < 0 {
+				value, rest = after[1:], ""
+			} else {
+				value, rest = after[1:end+1], after[end+2:]
+			}
+		} else {
+			value, rest, _ = strings.Cut(after, ",")
+		}
+		params[key] = value
+	}
+	return scheme, params
+}
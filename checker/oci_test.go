@@ -0,0 +1,184 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newOCIRegistry starts a stand-in registry that requires an anonymous bearer
+// token, issued by its own /token endpoint, before serving tags.
+func newOCIRegistry(t *testing.T, tags map[string]string) *httptest.Server {
+	t.Helper()
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			if r.URL.Query().Get("service") != "registry.test" {
+				t.Errorf("expected service 'registry.test', got %q", r.URL.Query().Get("service"))
+			}
+			_, _ = w.Write([]byte(`{"token":"tok-` + r.URL.Query().Get("scope") + `"}`))
+			return
+		}
+
+		var repo string
+		for name := range tags {
+			if r.URL.Path == "/v2/"+name+"/tags/list" {
+				repo = name
+			}
+		}
+		scope := "repository:" + repo + ":pull"
+		if repo == "" {
+			scope = "repository:unknown:pull"
+		}
+		if r.Header.Get("Authorization") != "Bearer tok-"+scope {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+srv.URL+`/token",service="registry.test",scope="`+scope+`"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if repo == "" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[{"code":"NAME_UNKNOWN"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"` + repo + `","tags":` + tags[repo] + `}`))
+	}))
+	return srv
+}
+
+func TestOCIChecker_TakenWithTokenDance(t *testing.T) {
+	srv := newOCIRegistry(t, map[string]string{
+		"grafana/grafana": `["10.0.0","10.1.0","latest"]`,
+	})
+	defer srv.Close()
+
+	c := NewOCIChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "Grafana")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	if result.Detail != "grafana/grafana (3 tags)" {
+		t.Errorf("expected detail 'grafana/grafana (3 tags)', got %q", result.Detail)
+	}
+}
+
+func TestOCIChecker_TakenTopLevelRepo(t *testing.T) {
+	srv := newOCIRegistry(t, map[string]string{
+		"busybox": `["1.36"]`,
+	})
+	defer srv.Close()
+
+	c := NewOCIChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "busybox")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	if result.Detail != "busybox (1 tag)" {
+		t.Errorf("expected detail 'busybox (1 tag)', got %q", result.Detail)
+	}
+}
+
+func TestOCIChecker_EmptyRepoIsAvailable(t *testing.T) {
+	srv := newOCIRegistry(t, map[string]string{
+		"empty/empty": `[]`,
+	})
+	defer srv.Close()
+
+	c := NewOCIChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "empty")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v (err: %v)", result.Status, result.Err)
+	}
+}
+
+func TestOCIChecker_Available(t *testing.T) {
+	srv := newOCIRegistry(t, map[string]string{})
+	defer srv.Close()
+
+	c := NewOCIChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v (err: %v)", result.Status, result.Err)
+	}
+}
+
+func TestOCIChecker_InvalidName(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request for %s", r.URL)
+	}))
+	defer srv.Close()
+
+	c := NewOCIChecker(srv.Client(), srv.URL)
+	for _, name := range []string{"my?tool", "my#tool", "my%2ftool", "-tool", "my..tool"} {
+		result := c.Check(context.Background(), name)
+		if result.Status != Invalid {
+			t.Errorf("%q: expected Invalid, got %v (err: %v)", name, result.Status, result.Err)
+		}
+	}
+}
+
+func TestOCIChecker_NoAuthRequired(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v2/myproject/tags/list" {
+			_, _ = w.Write([]byte(`{"name":"myproject","tags":["v1"]}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewOCIChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "myproject")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+}
+
+func TestOCIChecker_DeniedIsUnknown(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	c := NewOCIChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestOCIChecker_DisplayName(t *testing.T) {
+	c := NewOCIChecker(http.DefaultClient, "https://ghcr.io/")
+	if c.Name() != "oci" {
+		t.Errorf("expected name 'oci', got %q", c.Name())
+	}
+	if c.DisplayName() != "OCI (ghcr.io)" {
+		t.Errorf("expected display name 'OCI (ghcr.io)', got %q", c.DisplayName())
+	}
+}
+
+func TestParseAuthChallenge(t *testing.T) {
+	scheme, params := parseAuthChallenge(`Bearer realm="https://auth.example/token",service="example",scope="repository:a/b:pull,push"`)
+	if scheme != "Bearer" {
+		t.Errorf("expected scheme 'Bearer', got %q", scheme)
+	}
+	want := map[string]string{
+		"realm":   "https://auth.example/token",
+		"service": "example",
+		"scope":   "repository:a/b:pull,push",
+	}
+	for k, v := range want {
+		if params[k] != v {
+			t.Errorf("expected %s=%q, got %q", k, v, params[k])
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// PackagistChecker checks vendor and package name availability on Packagist.
+type PackagistChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewPackagistChecker(client *http.Client, baseURL string) *PackagistChecker {
+	return &PackagistChecker{client: client, baseURL: baseURL}
+}
+
+func (c *PackagistChecker) Name() string        { return "packagist" }
+func (c *PackagistChecker) DisplayName() string { return "Packagist" }
+
+func (c *PackagistChecker) Check(ctx context.Context, name string) Result {
+	// Composer package names are always lowercase.
+	vendor := strings.ToLower(name)
+	pkg := vendor + "/" + vendor
+
+	var (
+		vendorPackages        []string
+		pkgExists             bool
+		vendorErr, packageErr error
+		wg                    sync.WaitGroup
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		vendorPackages, vendorErr = c.listVendorPackages(ctx, vendor)
+	}()
+	go func() {
+		defer wg.Done()
+		pkgExists, packageErr = c.packageExists(ctx, vendor, vendor)
+	}()
+	wg.Wait()
+
+	// Composer ownership is per vendor, so each finding gets its own line.
+	// <name>/<name> gets a line of its own, so the vendor line only counts
+	// the vendor's other packages.
+	var others int
+	for _, p := range vendorPackages {
+		if !strings.EqualFold(p, pkg) {
+			others++
+		}
+	}
+	noun := "package"
+	if pkgExists {
+		noun = "other package"
+	}
+	var found []string
+	if others > 0 {
+		found = append(found, fmt.Sprintf("vendor %s/ has %s", vendor, pluralize(others, noun)))
+	}
+	if pkgExists {
+		found = append(found, "package "+pkg+" exists")
+	}
+
+	if len(found) > 0 {
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Taken,
+			Detail:   strings.Join(found, "\n"),
+		}
+	}
+
+	if vendorErr != nil {
+		vendorErr = fmt.Errorf("vendor: %w", vendorErr)
+	}
+	if packageErr != nil {
+		packageErr = fmt.Errorf("package: %w", packageErr)
+	}
+	if err := joinErrors(vendorErr, packageErr); err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+
+	return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+}
+
+// listVendorPackages returns the names of the packages published under vendor/.
+func (c *PackagistChecker) listVendorPackages(ctx context.Context, vendor string) ([]string, error) {
+	u := c.baseURL + "/packages/list.json?vendor=" + url.QueryEscape(vendor)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+
+	var data struct {
+		PackageNames []string `json:"packageNames"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&data); err != nil {
+		return nil, err
+	}
+	return data.PackageNames, nil
+}
+
+// packageExists returns (exists, error) for vendor/pkg.
+func (c *PackagistChecker) packageExists(ctx context.Context, vendor, pkg string) (bool, error) {
+	u := c.baseURL + "/packages/" + url.PathEscape(vendor) + "/" + url.PathEscape(pkg) + ".json"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,179 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestPackagistChecker_TakenVendorAndPackage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/packages/list.json":
+			_, _ = w.Write([]byte(`{"packageNames":["laravel/framework","laravel/laravel","laravel/sail"]}`))
+		case "/packages/laravel/laravel.json":
+			_, _ = w.Write([]byte(`{"package":{"name":"laravel/laravel"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewPackagistChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "laravel")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	want := "vendor laravel/ has 2 other packages\npackage laravel/laravel exists"
+	if result.Detail != want {
+		t.Errorf("expected detail %q, got %q", want, result.Detail)
+	}
+}
+
+func TestPackagistChecker_TakenPackageOnlyInVendor(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/packages/list.json":
+			_, _ = w.Write([]byte(`{"packageNames":["solo/solo"]}`))
+		case "/packages/solo/solo.json":
+			_, _ = w.Write([]byte(`{"package":{"name":"solo/solo"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewPackagistChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "solo")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "package solo/solo exists" {
+		t.Errorf("expected only the package line, got %q", result.Detail)
+	}
+}
+
+func TestPackagistChecker_TakenVendorOnly(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/packages/list.json" {
+			_, _ = w.Write([]byte(`{"packageNames":["acme/widgets"]}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewPackagistChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "acme")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "vendor acme/ has 1 package" {
+		t.Errorf("expected vendor detail, got %q", result.Detail)
+	}
+}
+
+func TestPackagistChecker_Available(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/packages/list.json" {
+			_, _ = w.Write([]byte(`{"packageNames":[]}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewPackagistChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+}
+
+func TestPackagistChecker_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewPackagistChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestPackagistChecker_VendorErrorIsUnknown(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/packages/list.json" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewPackagistChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "acme")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestPackagistChecker_URLs(t *testing.T) {
+	var mu sync.Mutex
+	var vendorQuery string
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		paths = append(paths, r.URL.Path)
+		if r.URL.Path == "/packages/list.json" {
+			vendorQuery = r.URL.Query().Get("vendor")
+			_, _ = w.Write([]byte(`{"packageNames":[]}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewPackagistChecker(srv.Client(), srv.URL)
+	c.Check(context.Background(), "MyProject")
+
+	if vendorQuery != "myproject" {
+		t.Errorf("expected lowercase vendor query 'myproject', got %q", vendorQuery)
+	}
+	found := false
+	for _, p := range paths {
+		if p == "/packages/myproject/myproject.json" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected package path '/packages/myproject/myproject.json', got %v", paths)
+	}
+}
+
+func TestPackagistChecker_Name(t *testing.T) {
+	c := NewPackagistChecker(http.DefaultClient, "")
+	if c.Name() != "packagist" {
+		t.Errorf("expected name 'packagist', got %q", c.Name())
+	}
+	if c.DisplayName() != "Packagist" {
+		t.Errorf("expected display name 'Packagist', got %q", c.DisplayName())
+	}
+}
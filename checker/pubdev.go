@@ -0,0 +1,118 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// PubDevChecker checks package name availability on pub.dev (Dart and Flutter).
+type PubDevChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewPubDevChecker(client *http.Client, baseURL string) *PubDevChecker {
+	return &PubDevChecker{client: client, baseURL: baseURL}
+}
+
+func (c *PubDevChecker) Name() string        { return "pubdev" }
+func (c *PubDevChecker) DisplayName() string { return "pub.dev" }
+
+var pubNamePattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// dartReservedWords can't be used as package names because pub derives the
+// import prefix from the name.
+var dartReservedWords = map[string]bool{
+	"assert": true, "break": true, "case": true, "catch": true, "class": true,
+	"const": true, "continue": true, "default": true, "do": true, "else": true,
+	"enum": true, "extends": true, "false": true, "final": true, "finally": true,
+	"for": true, "if": true, "in": true, "is": true, "new": true, "null": true,
+	"rethrow": true, "return": true, "super": true, "switch": true, "this": true,
+	"throw": true, "true": true, "try": true, "var": true, "void": true,
+	"while": true, "with": true,
+}
+
+func (c *PubDevChecker) Check(ctx context.Context, name string) Result {
+	if reason := validatePubName(name); reason != "" {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Invalid, Detail: reason}
+	}
+
+	u := c.baseURL + "/api/packages/" + url.PathEscape(name)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	req.Header.Set("Accept", "application/vnd.pub.v2+json")
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		detail := parsePubDevInfo(resp.Body)
+		return Result{Registry: c.DisplayName(), Name: name, Status: Taken, Detail: detail}
+	case http.StatusNotFound:
+		return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+	default:
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Unknown,
+			Err:      fmt.Errorf("unexpected status: %d", resp.StatusCode),
+		}
+	}
+}
+
+// validatePubName returns why name can't be a pub package, or "" if it can.
+func validatePubName(name string) string {
+	if !pubNamePattern.MatchString(name) {
+		reason := "pub names may only contain lowercase letters, digits and underscores"
+		if alt := strings.ToLower(strings.ReplaceAll(name, "-", "_")); alt != name && pubNamePattern.MatchString(alt) {
+			reason += " (try " + alt + ")"
+		}
+		return reason
+	}
+	if dartReservedWords[name] {
+		return name + " is a reserved word in Dart"
+	}
+	return ""
+}
+
+// parsePubDevInfo summarises the latest version and discontinued state. The
+// response embeds every version's pubspec, hence the generous read limit.
+func parsePubDevInfo(body io.Reader) string {
+	var data struct {
+		IsDiscontinued bool   `json:"isDiscontinued"`
+		ReplacedBy     string `json:"replacedBy"`
+		Latest         struct {
+			Version string `json:"version"`
+		} `json:"latest"`
+	}
+	if err := json.NewDecoder(io.LimitReader(body, 16<<20)).Decode(&data); err != nil {
+		return ""
+	}
+
+	var parts []string
+	if data.Latest.Version != "" {
+		parts = append(parts, "latest "+data.Latest.Version)
+	}
+	if data.IsDiscontinued {
+		d := "discontinued"
+		if data.ReplacedBy != "" {
+			d += " (replaced by " + data.ReplacedBy + ")"
+		}
+		parts = append(parts, d)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,130 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPubDevChecker_Taken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name":"provider","latest":{"version":"6.1.2"},"versions":[]}`))
+	}))
+	defer srv.Close()
+
+	c := NewPubDevChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "provider")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "latest 6.1.2" {
+		t.Errorf("expected detail 'latest 6.1.2', got %q", result.Detail)
+	}
+}
+
+func TestPubDevChecker_TakenDiscontinued(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name":"flutter_markdown","isDiscontinued":true,"replacedBy":"markdown_widget","latest":{"version":"0.7.7"}}`))
+	}))
+	defer srv.Close()
+
+	c := NewPubDevChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "flutter_markdown")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	want := "latest 0.7.7, discontinued (replaced by markdown_widget)"
+	if result.Detail != want {
+		t.Errorf("expected detail %q, got %q", want, result.Detail)
+	}
+}
+
+func TestPubDevChecker_Available(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewPubDevChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "xyzzy_nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+}
+
+func TestPubDevChecker_InvalidName(t *testing.T) {
+	requested := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		name   string
+		detail string
+	}{
+		{"my-tool", "pub names may only contain lowercase letters, digits and underscores (try my_tool)"},
+		{"MyTool", "pub names may only contain lowercase letters, digits and underscores (try mytool)"},
+		{"1tool", "pub names may only contain lowercase letters, digits and underscores"},
+		{"class", "class is a reserved word in Dart"},
+	}
+
+	c := NewPubDevChecker(srv.Client(), srv.URL)
+	for _, tt := range tests {
+		result := c.Check(context.Background(), tt.name)
+		if result.Status != Invalid {
+			t.Errorf("Check(%q): expected Invalid, got %v", tt.name, result.Status)
+		}
+		if result.Detail != tt.detail {
+			t.Errorf("Check(%q): expected detail %q, got %q", tt.name, tt.detail, result.Detail)
+		}
+	}
+	if requested {
+		t.Error("expected no request for names pub.dev would reject")
+	}
+}
+
+func TestPubDevChecker_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewPubDevChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+}
+
+func TestPubDevChecker_URLPath(t *testing.T) {
+	var receivedPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedPath = r.URL.Path
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewPubDevChecker(srv.Client(), srv.URL)
+	c.Check(context.Background(), "my_project")
+
+	if receivedPath != "/api/packages/my_project" {
+		t.Errorf("expected path '/api/packages/my_project', got %q", receivedPath)
+	}
+}
+
+func TestPubDevChecker_Name(t *testing.T) {
+	c := NewPubDevChecker(http.DefaultClient, "")
+	if c.Name() != "pubdev" {
+		t.Errorf("expected name 'pubdev', got %q", c.Name())
+	}
+	if c.DisplayName() != "pub.dev" {
+		t.Errorf("expected display name 'pub.dev', got %q", c.DisplayName())
+	}
+}
@@ -0,0 +1,106 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// PyPIChecker checks project name availability on the Python Package Index.
+type PyPIChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewPyPIChecker(client *http.Client, baseURL string) *PyPIChecker {
+	return &PyPIChecker{client: client, baseURL: baseURL}
+}
+
+func (c *PyPIChecker) Name() string        { return "pypi" }
+func (c *PyPIChecker) DisplayName() string { return "PyPI" }
+
+func (c *PyPIChecker) Check(ctx context.Context, name string) Result {
+	u := c.baseURL + "/pypi/" + url.PathEscape(normalizePyPIName(name)) + "/json"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		detail := parsePyPIInfo(resp.Body)
+		return Result{Registry: c.DisplayName(), Name: name, Status: Taken, Detail: detail}
+	case http.StatusNotFound:
+		return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+	default:
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Unknown,
+			Err:      fmt.Errorf("unexpected status: %d", resp.StatusCode),
+		}
+	}
+}
+
+var pypiSeparators = regexp.MustCompile(`[-_.]+`)
+
+// normalizePyPIName applies PEP 503 normalization, so that "My_Pkg", "my-pkg"
+// and "my.pkg" all refer to the same project.
+func normalizePyPIName(name string) string {
+	return pypiSeparators.ReplaceAllString(strings.ToLower(name), "-")
+}
+
+// parsePyPIInfo extracts the summary and latest version from the "info" object.
+// The response also lists every release file, which can run to several megabytes
+// for popular projects, so the body is streamed and decoding stops at "info".
+func parsePyPIInfo(body io.Reader) string {
+	var info struct {
+		Summary string `json:"summary"`
+		Version string `json:"version"`
+	}
+
+	dec := json.NewDecoder(io.LimitReader(body, 1<<20))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return ""
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return ""
+		}
+		if key, _ := tok.(string); key == "info" {
+			if err := dec.Decode(&info); err != nil {
+				return ""
+			}
+			break
+		}
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return ""
+		}
+	}
+
+	summary := strings.TrimSpace(info.Summary)
+	switch {
+	case summary != "" && info.Version != "":
+		return summary + " (latest " + info.Version + ")"
+	case info.Version != "":
+		return "latest " + info.Version
+	default:
+		return summary
+	}
+}
@@ -0,0 +1,138 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestPyPIChecker_Taken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"info":{"name":"requests","summary":"Python HTTP for Humans.","version":"2.32.3"},"releases":{}}`))
+	}))
+	defer srv.Close()
+
+	c := NewPyPIChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "requests")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Registry != "PyPI" {
+		t.Errorf("expected registry 'PyPI', got %q", result.Registry)
+	}
+	if result.Detail != "Python HTTP for Humans. (latest 2.32.3)" {
+		t.Errorf("expected summary and version in detail, got %q", result.Detail)
+	}
+}
+
+func TestPyPIChecker_TakenInfoAfterReleases(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"last_serial":1,"releases":{"1.0":[{"filename":"x.whl"}]},"info":{"summary":"","version":"1.0"}}`))
+	}))
+	defer srv.Close()
+
+	c := NewPyPIChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "x")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "latest 1.0" {
+		t.Errorf("expected detail 'latest 1.0', got %q", result.Detail)
+	}
+}
+
+func TestPyPIChecker_Available(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewPyPIChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+}
+
+func TestPyPIChecker_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewPyPIChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestPyPIChecker_Timeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(5 * time.Second)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	c := NewPyPIChecker(srv.Client(), srv.URL)
+	result := c.Check(ctx, "anything")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown on timeout, got %v", result.Status)
+	}
+}
+
+func TestPyPIChecker_NormalizedURLPath(t *testing.T) {
+	tests := []struct {
+		input string
+		path  string
+	}{
+		{"My_Pkg", "/pypi/my-pkg/json"},
+		{"my.pkg", "/pypi/my-pkg/json"},
+		{"my-pkg", "/pypi/my-pkg/json"},
+		{"My__Weird.-_Pkg", "/pypi/my-weird-pkg/json"},
+	}
+
+	for _, tt := range tests {
+		var receivedPath string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedPath = r.URL.Path
+			w.WriteHeader(http.StatusNotFound)
+		}))
+
+		c := NewPyPIChecker(srv.Client(), srv.URL)
+		result := c.Check(context.Background(), tt.input)
+		srv.Close()
+
+		if receivedPath != tt.path {
+			t.Errorf("Check(%q): expected path %q, got %q", tt.input, tt.path, receivedPath)
+		}
+		if result.Name != tt.input {
+			t.Errorf("Check(%q): expected result name to keep input, got %q", tt.input, result.Name)
+		}
+	}
+}
+
+func TestPyPIChecker_Name(t *testing.T) {
+	c := NewPyPIChecker(http.DefaultClient, "")
+	if c.Name() != "pypi" {
+		t.Errorf("expected name 'pypi', got %q", c.Name())
+	}
+	if c.DisplayName() != "PyPI" {
+		t.Errorf("expected display name 'PyPI', got %q", c.DisplayName())
+	}
+}
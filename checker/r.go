@@ -0,0 +1,159 @@
+package checker
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// RChecker checks R package name availability on CRAN (current and archived
+// packages) and Bioconductor.
+type RChecker struct {
+	client  *http.Client
+	cranURL string
+	biocURL string
+}
+
+// NewRChecker creates an RChecker. cranURL points at a CRAN package database
+// (crandb) and biocURL at a Bioconductor mirror.
+func NewRChecker(client *http.Client, cranURL, biocURL string) *RChecker {
+	return &RChecker{client: client, cranURL: cranURL, biocURL: biocURL}
+}
+
+func (c *RChecker) Name() string        { return "r" }
+func (c *RChecker) DisplayName() string { return "CRAN / Bioconductor" }
+
+type cranPackage struct {
+	Found    bool
+	Archived bool
+	Latest   string
+}
+
+func (c *RChecker) Check(ctx context.Context, name string) Result {
+	var (
+		cran             cranPackage
+		inBioc           bool
+		cranErr, biocErr error
+		wg               sync.WaitGroup
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		cran, cranErr = c.lookupCRAN(ctx, name)
+	}()
+	go func() {
+		defer wg.Done()
+		inBioc, biocErr = c.lookupBioconductor(ctx, name)
+	}()
+	wg.Wait()
+
+	var found []string
+	switch {
+	case cran.Archived:
+		// CRAN never lets a new package reuse an archived name.
+		found = append(found, "archived on CRAN (names are never reused)")
+	case cran.Found:
+		line := "CRAN package"
+		if cran.Latest != "" {
+			line += " (latest " + cran.Latest + ")"
+		}
+		found = append(found, line)
+	}
+	if inBioc {
+		found = append(found, "Bioconductor package")
+	}
+
+	if len(found) > 0 {
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Taken,
+			Detail:   strings.Join(found, "\n"),
+		}
+	}
+
+	// A failed CRAN lookup could be hiding an archived name, so nothing
+	// found is only "available" if every lookup succeeded.
+	if cranErr != nil {
+		cranErr = fmt.Errorf("CRAN: %w", cranErr)
+	}
+	if biocErr != nil {
+		biocErr = fmt.Errorf("Bioconductor: %w", biocErr)
+	}
+	if err := joinErrors(cranErr, biocErr); err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+
+	return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+}
+
+// lookupCRAN queries crandb's "/<name>/all" document, which exists for both
+// current and archived packages.
+func (c *RChecker) lookupCRAN(ctx context.Context, name string) (cranPackage, error) {
+	u := c.cranURL + "/" + url.PathEscape(name) + "/all"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return cranPackage{}, err
+	}
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return cranPackage{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		var data struct {
+			Archived bool   `json:"archived"`
+			Latest   string `json:"latest"`
+		}
+		if err := json.NewDecoder(io.LimitReader(resp.Body, 4<<20)).Decode(&data); err != nil {
+			return cranPackage{}, err
+		}
+		return cranPackage{Found: true, Archived: data.Archived, Latest: data.Latest}, nil
+	case http.StatusNotFound:
+		return cranPackage{}, nil
+	default:
+		return cranPackage{}, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+}
+
+// lookupBioconductor scans the release PACKAGES index for a "Package:" entry
+// matching name.
+func (c *RChecker) lookupBioconductor(ctx context.Context, name string) (bool, error) {
+	u := c.biocURL + "/packages/release/bioc/src/contrib/PACKAGES"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+
+	sc := bufio.NewScanner(io.LimitReader(resp.Body, 16<<20))
+	for sc.Scan() {
+		pkg, ok := strings.CutPrefix(sc.Text(), "Package:")
+		if ok && strings.TrimSpace(pkg) == name {
+			return true, nil
+		}
+	}
+	return false, sc.Err()
+}
@@ -0,0 +1,150 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const biocPackages = `Package: limma
+Version: 3.58.1
+Depends: R (>= 3.6.0)
+
+Package: DESeq2
+Version: 1.42.0
+`
+
+func newRServers(cran, bioc http.HandlerFunc) (*httptest.Server, *httptest.Server) {
+	return httptest.NewServer(cran), httptest.NewServer(bioc)
+}
+
+func servePackages(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/packages/release/bioc/src/contrib/PACKAGES" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_, _ = w.Write([]byte(biocPackages))
+}
+
+func cranNotFound(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotFound)
+	_, _ = w.Write([]byte(`{"error":"not_found","reason":"missing"}`))
+}
+
+func TestRChecker_TakenCRAN(t *testing.T) {
+	var receivedPath string
+	cran, bioc := newRServers(func(w http.ResponseWriter, r *http.Request) {
+		receivedPath = r.URL.Path
+		_, _ = w.Write([]byte(`{"name":"ggplot2","archived":false,"latest":"3.5.1"}`))
+	}, servePackages)
+	defer cran.Close()
+	defer bioc.Close()
+
+	c := NewRChecker(http.DefaultClient, cran.URL, bioc.URL)
+	result := c.Check(context.Background(), "ggplot2")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "CRAN package (latest 3.5.1)" {
+		t.Errorf("expected CRAN detail, got %q", result.Detail)
+	}
+	if receivedPath != "/ggplot2/all" {
+		t.Errorf("expected path '/ggplot2/all', got %q", receivedPath)
+	}
+}
+
+func TestRChecker_ArchivedIsTaken(t *testing.T) {
+	cran, bioc := newRServers(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name":"oldpkg","archived":true,"latest":"0.1"}`))
+	}, servePackages)
+	defer cran.Close()
+	defer bioc.Close()
+
+	c := NewRChecker(http.DefaultClient, cran.URL, bioc.URL)
+	result := c.Check(context.Background(), "oldpkg")
+
+	if result.Status != Taken {
+		t.Errorf("expected archived package to be Taken, got %v", result.Status)
+	}
+	if result.Detail != "archived on CRAN (names are never reused)" {
+		t.Errorf("expected archived detail, got %q", result.Detail)
+	}
+}
+
+func TestRChecker_TakenBioconductor(t *testing.T) {
+	cran, bioc := newRServers(cranNotFound, servePackages)
+	defer cran.Close()
+	defer bioc.Close()
+
+	c := NewRChecker(http.DefaultClient, cran.URL, bioc.URL)
+	result := c.Check(context.Background(), "DESeq2")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "Bioconductor package" {
+		t.Errorf("expected Bioconductor detail, got %q", result.Detail)
+	}
+}
+
+func TestRChecker_Available(t *testing.T) {
+	cran, bioc := newRServers(cranNotFound, servePackages)
+	defer cran.Close()
+	defer bioc.Close()
+
+	c := NewRChecker(http.DefaultClient, cran.URL, bioc.URL)
+	result := c.Check(context.Background(), "xyzzynonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+}
+
+func TestRChecker_ServerError(t *testing.T) {
+	fail := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusInternalServerError) }
+	cran, bioc := newRServers(fail, fail)
+	defer cran.Close()
+	defer bioc.Close()
+
+	c := NewRChecker(http.DefaultClient, cran.URL, bioc.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestRChecker_CRANErrorIsUnknown(t *testing.T) {
+	// An unreachable CRAN could be hiding an archived name, so a Bioconductor
+	// miss alone must not make the name available.
+	cran, bioc := newRServers(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}, servePackages)
+	defer cran.Close()
+	defer bioc.Close()
+
+	c := NewRChecker(http.DefaultClient, cran.URL, bioc.URL)
+	result := c.Check(context.Background(), "xyzzynonexistent")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestRChecker_Name(t *testing.T) {
+	c := NewRChecker(http.DefaultClient, "", "")
+	if c.Name() != "r" {
+		t.Errorf("expected name 'r', got %q", c.Name())
+	}
+	if c.DisplayName() != "CRAN / Bioconductor" {
+		t.Errorf("expected display name 'CRAN / Bioconductor', got %q", c.DisplayName())
+	}
+}
@@ -0,0 +1,145 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// RubyGemsChecker checks gem name availability on rubygems.org.
+type RubyGemsChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewRubyGemsChecker(client *http.Client, baseURL string) *RubyGemsChecker {
+	return &RubyGemsChecker{client: client, baseURL: baseURL}
+}
+
+func (c *RubyGemsChecker) Name() string        { return "rubygems" }
+func (c *RubyGemsChecker) DisplayName() string { return "RubyGems" }
+
+func (c *RubyGemsChecker) Check(ctx context.Context, name string) Result {
+	u := c.baseURL + "/api/v1/gems/" + url.PathEscape(name) + ".json"
+
+	// Owners only matter if the gem exists, but fetching them alongside the
+	// gem saves a round trip when it does.
+	owners := make(chan []string, 1)
+	go func() { owners <- c.fetchOwners(ctx, name) }()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		detail := parseRubyGemsInfo(resp.Body)
+		// Owners are a nice-to-have; a failed lookup doesn't change the verdict.
+		if o := <-owners; len(o) > 0 {
+			detail = joinNonEmpty(", ", detail, "owners: "+strings.Join(o, ", "))
+		}
+		return Result{Registry: c.DisplayName(), Name: name, Status: Taken, Detail: detail}
+	case http.StatusNotFound:
+		return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+	case http.StatusTooManyRequests:
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Unknown,
+			Err:      fmt.Errorf("rate limited"),
+		}
+	default:
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Unknown,
+			Err:      fmt.Errorf("unexpected status: %d", resp.StatusCode),
+		}
+	}
+}
+
+func (c *RubyGemsChecker) fetchOwners(ctx context.Context, name string) []string {
+	u := c.baseURL + "/api/v1/gems/" + url.PathEscape(name) + "/owners.json"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil
+	}
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil
+	}
+
+	var data []struct {
+		Handle string `json:"handle"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 65536)).Decode(&data); err != nil {
+		return nil
+	}
+	var owners []string
+	for _, o := range data {
+		if o.Handle != "" {
+			owners = append(owners, o.Handle)
+		}
+	}
+	return owners
+}
+
+func parseRubyGemsInfo(body io.Reader) string {
+	var data struct {
+		Downloads int64  `json:"downloads"`
+		Version   string `json:"version"`
+	}
+	if err := json.NewDecoder(io.LimitReader(body, 65536)).Decode(&data); err != nil {
+		return ""
+	}
+
+	detail := formatCount(data.Downloads) + " downloads"
+	if data.Version != "" {
+		detail += ", latest " + data.Version
+	}
+	return detail
+}
+
+// formatCount renders n with thousands separators (e.g. 1234567 -> "1,234,567").
+func formatCount(n int64) string {
+	if n < 0 {
+		return "-" + formatCount(-n)
+	}
+	s := strconv.FormatInt(n, 10)
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return s
+}
+
+// joinNonEmpty joins the non-empty parts with sep.
+func joinNonEmpty(sep string, parts ...string) string {
+	var out []string
+	for _, p := range parts {
+		if p != "" {
+			out = append(out, p)
+		}
+	}
+	return strings.Join(out, sep)
+}
@@ -0,0 +1,196 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRubyGemsChecker_Taken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/gems/rails.json":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"name":"rails","downloads":612345678,"version":"7.1.3"}`))
+		case "/api/v1/gems/rails/owners.json":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`[{"handle":"dhh"},{"handle":"rafaelfranca"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewRubyGemsChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "rails")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Registry != "RubyGems" {
+		t.Errorf("expected registry 'RubyGems', got %q", result.Registry)
+	}
+	want := "612,345,678 downloads, latest 7.1.3, owners: dhh, rafaelfranca"
+	if result.Detail != want {
+		t.Errorf("expected detail %q, got %q", want, result.Detail)
+	}
+}
+
+func TestRubyGemsChecker_TakenOwnersUnavailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/gems/dead.json" {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"name":"dead","downloads":42,"version":"0.0.1"}`))
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewRubyGemsChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "dead")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "42 downloads, latest 0.0.1" {
+		t.Errorf("expected detail '42 downloads, latest 0.0.1', got %q", result.Detail)
+	}
+}
+
+func TestRubyGemsChecker_Available(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`This rubygem could not be found.`))
+	}))
+	defer srv.Close()
+
+	c := NewRubyGemsChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+}
+
+func TestRubyGemsChecker_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewRubyGemsChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestRubyGemsChecker_RateLimited(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	c := NewRubyGemsChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil || result.Err.Error() != "rate limited" {
+		t.Errorf("expected 'rate limited' error, got %v", result.Err)
+	}
+}
+
+func TestRubyGemsChecker_Timeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(5 * time.Second)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	c := NewRubyGemsChecker(srv.Client(), srv.URL)
+	result := c.Check(ctx, "anything")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown on timeout, got %v", result.Status)
+	}
+}
+
+func TestRubyGemsChecker_URLPath(t *testing.T) {
+	var mu sync.Mutex
+	paths := map[string]bool{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths[r.URL.Path] = true
+		mu.Unlock()
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewRubyGemsChecker(srv.Client(), srv.URL)
+	c.Check(context.Background(), "myproject")
+
+	mu.Lock()
+	defer mu.Unlock()
+	if !paths["/api/v1/gems/myproject.json"] {
+		t.Errorf("expected path '/api/v1/gems/myproject.json', got %v", paths)
+	}
+}
+
+func TestRubyGemsChecker_OwnersFetchedInParallel(t *testing.T) {
+	ownersRequested := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/gems/rails.json":
+			// Only answer once the owners request is in flight too.
+			select {
+			case <-ownersRequested:
+			case <-time.After(2 * time.Second):
+				t.Error("owners were not requested alongside the gem")
+			}
+			_, _ = w.Write([]byte(`{"name":"rails","downloads":1,"version":"7.1.3"}`))
+		case "/api/v1/gems/rails/owners.json":
+			close(ownersRequested)
+			_, _ = w.Write([]byte(`[{"handle":"dhh"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewRubyGemsChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "rails")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "1 downloads, latest 7.1.3, owners: dhh" {
+		t.Errorf("expected detail '1 downloads, latest 7.1.3, owners: dhh', got %q", result.Detail)
+	}
+}
+
+func TestFormatCount(t *testing.T) {
+	tests := map[int64]string{
+		0:       "0",
+		999:     "999",
+		1000:    "1,000",
+		1234567: "1,234,567",
+		-12345:  "-12,345",
+	}
+	for in, want := range tests {
+		if got := formatCount(in); got != want {
+			t.Errorf("formatCount(%d) = %q, want %q", in, got, want)
+		}
+	}
+}
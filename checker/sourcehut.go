@@ -0,0 +1,53 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// SourceHutChecker checks ~user availability on SourceHut by probing the
+// account's profile page, which exists for every user whether or not they
+// have published repositories.
+type SourceHutChecker struct {
+	client  *http.Client
+	baseURL string
+}
+
+func NewSourceHutChecker(client *http.Client, baseURL string) *SourceHutChecker {
+	return &SourceHutChecker{client: client, baseURL: baseURL}
+}
+
+func (c *SourceHutChecker) Name() string        { return "sourcehut" }
+func (c *SourceHutChecker) DisplayName() string { return "SourceHut" }
+
+func (c *SourceHutChecker) Check(ctx context.Context, name string) Result {
+	u := c.baseURL + "/~" + url.PathEscape(name)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return Result{Registry: c.DisplayName(), Name: name, Status: Taken, Detail: "~" + name}
+	case http.StatusNotFound:
+		return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+	default:
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Unknown,
+			Err:      fmt.Errorf("unexpected status: %d", resp.StatusCode),
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package checker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSourceHutChecker_Taken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><title>~sircmpwn</title></html>`))
+	}))
+	defer srv.Close()
+
+	c := NewSourceHutChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "sircmpwn")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v", result.Status)
+	}
+	if result.Detail != "~sircmpwn" {
+		t.Errorf("expected detail '~sircmpwn', got %q", result.Detail)
+	}
+}
+
+func TestSourceHutChecker_Available(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewSourceHutChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v", result.Status)
+	}
+}
+
+func TestSourceHutChecker_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewSourceHutChecker(srv.Client(), srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestSourceHutChecker_URLPath(t *testing.T) {
+	var receivedPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedPath = r.URL.Path
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewSourceHutChecker(srv.Client(), srv.URL)
+	c.Check(context.Background(), "myproject")
+
+	if receivedPath != "/~myproject" {
+		t.Errorf("expected path '/~myproject', got %q", receivedPath)
+	}
+}
+
+func TestSourceHutChecker_Name(t *testing.T) {
+	c := NewSourceHutChecker(http.DefaultClient, "")
+	if c.Name() != "sourcehut" {
+		t.Errorf("expected name 'sourcehut', got %q", c.Name())
+	}
+	if c.DisplayName() != "SourceHut" {
+		t.Errorf("expected display name 'SourceHut', got %q", c.DisplayName())
+	}
+}
@@ -0,0 +1,259 @@
+package checker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// vscodeTopN is how many extensions named <name> are reported per store.
+const vscodeTopN = 3
+
+// VSCodeChecker checks publisher IDs and extension names on the Visual Studio
+// Marketplace and Open VSX. Publisher IDs are first come, first served, and
+// an extension <name> under any publisher competes for the same search slot.
+type VSCodeChecker struct {
+	client         *http.Client
+	marketplaceURL string
+	openVSXURL     string
+}
+
+// NewVSCodeChecker creates a VSCodeChecker. marketplaceURL points at the
+// Visual Studio Marketplace and openVSXURL at an Open VSX registry.
+func NewVSCodeChecker(client *http.Client, marketplaceURL, openVSXURL string) *VSCodeChecker {
+	return &VSCodeChecker{client: client, marketplaceURL: marketplaceURL, openVSXURL: openVSXURL}
+}
+
+func (c *VSCodeChecker) Name() string        { return "vscode" }
+func (c *VSCodeChecker) DisplayName() string { return "VS Code extensions" }
+
+func (c *VSCodeChecker) Check(ctx context.Context, name string) Result {
+	lookups := []func(context.Context, string) ([]string, error){
+		c.lookupMarketplacePublisher,
+		c.lookupMarketplaceExtensions,
+		c.lookupOpenVSXNamespace,
+		c.lookupOpenVSXExtensions,
+	}
+
+	found := make([][]string, len(lookups))
+	errs := make([]error, len(lookups))
+	var wg sync.WaitGroup
+	for i, lookup := range lookups {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			found[i], errs[i] = lookup(ctx, name)
+		}()
+	}
+	wg.Wait()
+
+	var lines []string
+	for _, f := range found {
+		lines = append(lines, f...)
+	}
+
+	if len(lines) > 0 {
+		return Result{
+			Registry: c.DisplayName(),
+			Name:     name,
+			Status:   Taken,
+			Detail:   strings.Join(lines, "\n"),
+		}
+	}
+
+	if err := joinErrors(errs...); err != nil {
+		return Result{Registry: c.DisplayName(), Name: name, Status: Unknown, Err: err}
+	}
+
+	return Result{Registry: c.DisplayName(), Name: name, Status: Available}
+}
+
+// Marketplace gallery query constants, see the extensionquery API.
+const (
+	marketplaceFilterTarget        = 8
+	marketplaceFilterSearchText    = 10
+	marketplaceFilterPublisherName = 18
+)
+
+type marketplaceExtension struct {
+	Publisher struct {
+		PublisherName string `json:"publisherName"`
+	} `json:"publisher"`
+	ExtensionName string `json:"extensionName"`
+}
+
+// lookupMarketplacePublisher checks for a Marketplace publisher with ID name by
+// listing its extensions, whatever they are called.
+func (c *VSCodeChecker) lookupMarketplacePublisher(ctx context.Context, name string) ([]string, error) {
+	exts, err := c.queryMarketplace(ctx, marketplaceFilterPublisherName, name, 1)
+	if err != nil {
+		return nil, fmt.Errorf("marketplace publisher: %w", err)
+	}
+	for _, ext := range exts {
+		if strings.EqualFold(ext.Publisher.PublisherName, name) {
+			return []string{"Marketplace publisher " + ext.Publisher.PublisherName}, nil
+		}
+	}
+	return nil, nil
+}
+
+// lookupMarketplaceExtensions searches the Marketplace for extensions named
+// name under any publisher.
+func (c *VSCodeChecker) lookupMarketplaceExtensions(ctx context.Context, name string) ([]string, error) {
+	exts, err := c.queryMarketplace(ctx, marketplaceFilterSearchText, name, 100)
+	if err != nil {
+		return nil, fmt.Errorf("marketplace search: %w", err)
+	}
+	var lines []string
+	for _, ext := range exts {
+		if strings.EqualFold(ext.ExtensionName, name) {
+			lines = append(lines, "Marketplace extension "+ext.Publisher.PublisherName+"."+ext.ExtensionName)
+			if len(lines) == vscodeTopN {
+				break
+			}
+		}
+	}
+	return lines, nil
+}
+
+// queryMarketplace runs an extensionquery for VS Code extensions matching one
+// filter criterion.
+func (c *VSCodeChecker) queryMarketplace(ctx context.Context, filterType int, value string, pageSize int) ([]marketplaceExtension, error) {
+	query := map[string]any{
+		"filters": []map[string]any{{
+			"criteria": []map[string]any{
+				{"filterType": marketplaceFilterTarget, "value": "Microsoft.VisualStudio.Code"},
+				{"filterType": filterType, "value": value},
+			},
+			"pageNumber": 1,
+			"pageSize":   pageSize,
+		}},
+		"flags": 0,
+	}
+	body, err := json.Marshal(query)
+	if err != nil {
+		return nil, err
+	}
+
+	u := c.marketplaceURL + "/_apis/public/gallery/extensionquery"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json;api-version=3.0-preview.1")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusTooManyRequests:
+		return nil, fmt.Errorf("rate limited")
+	default:
+		return nil, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+
+	var data struct {
+		Results []struct {
+			Extensions []marketplaceExtension `json:"extensions"`
+		} `json:"results"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 4<<20)).Decode(&data); err != nil {
+		return nil, err
+	}
+
+	var exts []marketplaceExtension
+	for _, r := range data.Results {
+		exts = append(exts, r.Extensions...)
+	}
+	return exts, nil
+}
+
+// lookupOpenVSXNamespace checks for an Open VSX namespace (publisher) named name.
+func (c *VSCodeChecker) lookupOpenVSXNamespace(ctx context.Context, name string) ([]string, error) {
+	var data struct {
+		Name string `json:"name"`
+	}
+	found, err := c.getOpenVSX(ctx, "/api/"+url.PathEscape(name), &data)
+	if err != nil {
+		return nil, fmt.Errorf("open vsx: %w", err)
+	}
+	if !found {
+		return nil, nil
+	}
+	if data.Name == "" {
+		data.Name = name
+	}
+	return []string{"Open VSX namespace " + data.Name}, nil
+}
+
+// lookupOpenVSXExtensions looks for extensions named name under any namespace,
+// i.e. any /api/<namespace>/<name>.
+func (c *VSCodeChecker) lookupOpenVSXExtensions(ctx context.Context, name string) ([]string, error) {
+	q := url.Values{}
+	q.Set("query", name)
+	q.Set("size", strconv.Itoa(100))
+
+	var data struct {
+		Extensions []struct {
+			Namespace string `json:"namespace"`
+			Name      string `json:"name"`
+		} `json:"extensions"`
+	}
+	if _, err := c.getOpenVSX(ctx, "/api/-/search?"+q.Encode(), &data); err != nil {
+		return nil, fmt.Errorf("open vsx search: %w", err)
+	}
+
+	var lines []string
+	for _, ext := range data.Extensions {
+		if strings.EqualFold(ext.Name, name) {
+			lines = append(lines, "Open VSX extension "+ext.Namespace+"."+ext.Name)
+			if len(lines) == vscodeTopN {
+				break
+			}
+		}
+	}
+	return lines, nil
+}
+
+// getOpenVSX decodes the Open VSX response for path into v. It returns false
+// for 404.
+func (c *VSCodeChecker) getOpenVSX(ctx context.Context, path string, v any) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.openVSXURL+path, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("User-Agent", "nsprobe/1.0")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if err := json.NewDecoder(io.LimitReader(resp.Body, 4<<20)).Decode(v); err != nil {
+			return false, err
+		}
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	case http.StatusTooManyRequests:
+		return false, fmt.Errorf("rate limited")
+	default:
+		return false, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,217 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// newVSCodeServer serves the Marketplace and Open VSX endpoints from one
+// server. marketplace is every extension on the Marketplace; extensionquery
+// returns those by the requested publisher, or those whose name contains the
+// search text.
+func newVSCodeServer(t *testing.T, marketplace string, namespaces map[string]bool, openVSX string) *httptest.Server {
+	t.Helper()
+	var all []marketplaceExtension
+	if err := json.Unmarshal([]byte(marketplace), &all); err != nil {
+		t.Fatalf("parsing marketplace fixture: %v", err)
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/_apis/public/gallery/extensionquery":
+			if r.Method != http.MethodPost {
+				t.Errorf("expected POST, got %s", r.Method)
+			}
+			var query marketplaceQuery
+			if err := json.NewDecoder(r.Body).Decode(&query); err != nil {
+				t.Errorf("decoding query: %v", err)
+			}
+			publisher, publisherOK := query.criterion(18)
+			text, textOK := query.criterion(10)
+			exts := []marketplaceExtension{}
+			for _, ext := range all {
+				if publisherOK && strings.EqualFold(ext.Publisher.PublisherName, publisher) ||
+					textOK && strings.Contains(ext.ExtensionName, text) {
+					exts = append(exts, ext)
+				}
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"results": []map[string]any{{"extensions": exts}},
+			})
+		case r.URL.Path == "/api/-/search":
+			_, _ = w.Write([]byte(`{"extensions":` + openVSX + `}`))
+		case namespaces[r.URL.Path]:
+			_, _ = w.Write([]byte(`{"name":"` + r.URL.Path[len("/api/"):] + `"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+// marketplaceQuery is the part of an extensionquery request the tests inspect.
+type marketplaceQuery struct {
+	Filters []struct {
+		Criteria []struct {
+			FilterType int    `json:"filterType"`
+			Value      string `json:"value"`
+		} `json:"criteria"`
+	} `json:"filters"`
+}
+
+// criterion returns the value of the first filterType criterion.
+func (q marketplaceQuery) criterion(filterType int) (string, bool) {
+	for _, f := range q.Filters {
+		for _, crit := range f.Criteria {
+			if crit.FilterType == filterType {
+				return crit.Value, true
+			}
+		}
+	}
+	return "", false
+}
+
+func TestVSCodeChecker_TakenPublisher(t *testing.T) {
+	srv := newVSCodeServer(t,
+		`[{"publisher":{"publisherName":"esbenp"},"extensionName":"prettier-vscode"}]`,
+		nil, `[]`)
+	defer srv.Close()
+
+	c := NewVSCodeChecker(srv.Client(), srv.URL, srv.URL)
+	result := c.Check(context.Background(), "esbenp")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	if result.Detail != "Marketplace publisher esbenp" {
+		t.Errorf("expected detail 'Marketplace publisher esbenp', got %q", result.Detail)
+	}
+}
+
+func TestVSCodeChecker_TakenExtensionsAndNamespace(t *testing.T) {
+	srv := newVSCodeServer(t,
+		`[{"publisher":{"publisherName":"ms-python"},"extensionName":"python"},`+
+			`{"publisher":{"publisherName":"ms-python"},"extensionName":"vscode-pylance"}]`,
+		map[string]bool{"/api/python": true},
+		`[{"namespace":"ms-python","name":"python"},{"namespace":"someone","name":"python-snippets"}]`)
+	defer srv.Close()
+
+	c := NewVSCodeChecker(srv.Client(), srv.URL, srv.URL)
+	result := c.Check(context.Background(), "python")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	want := "Marketplace extension ms-python.python\n" +
+		"Open VSX namespace python\n" +
+		"Open VSX extension ms-python.python"
+	if result.Detail != want {
+		t.Errorf("expected detail %q, got %q", want, result.Detail)
+	}
+}
+
+func TestVSCodeChecker_Available(t *testing.T) {
+	srv := newVSCodeServer(t,
+		`[{"publisher":{"publisherName":"other"},"extensionName":"xyzzy-nonexistent-pack"}]`,
+		nil, `[]`)
+	defer srv.Close()
+
+	c := NewVSCodeChecker(srv.Client(), srv.URL, srv.URL)
+	result := c.Check(context.Background(), "xyzzy-nonexistent")
+
+	if result.Status != Available {
+		t.Errorf("expected Available, got %v (err: %v)", result.Status, result.Err)
+	}
+}
+
+func TestVSCodeChecker_ServerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := NewVSCodeChecker(srv.Client(), srv.URL, srv.URL)
+	result := c.Check(context.Background(), "test")
+
+	if result.Status != Unknown {
+		t.Errorf("expected Unknown, got %v", result.Status)
+	}
+	if result.Err == nil {
+		t.Error("expected non-nil error")
+	}
+}
+
+func TestVSCodeChecker_PublisherWithUnrelatedExtensions(t *testing.T) {
+	srv := newVSCodeServer(t,
+		`[{"publisher":{"publisherName":"redhat"},"extensionName":"java"},`+
+			`{"publisher":{"publisherName":"redhat"},"extensionName":"vscode-yaml"}]`,
+		nil, `[]`)
+	defer srv.Close()
+
+	c := NewVSCodeChecker(srv.Client(), srv.URL, srv.URL)
+	result := c.Check(context.Background(), "redhat")
+
+	if result.Status != Taken {
+		t.Errorf("expected Taken, got %v (err: %v)", result.Status, result.Err)
+	}
+	if result.Detail != "Marketplace publisher redhat" {
+		t.Errorf("expected detail 'Marketplace publisher redhat', got %q", result.Detail)
+	}
+}
+
+func TestVSCodeChecker_MarketplaceQuery(t *testing.T) {
+	var mu sync.Mutex
+	var queries []marketplaceQuery
+	var accept string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/_apis/public/gallery/extensionquery" {
+			var query marketplaceQuery
+			if err := json.NewDecoder(r.Body).Decode(&query); err != nil {
+				t.Errorf("decoding query: %v", err)
+			}
+			mu.Lock()
+			accept = r.Header.Get("Accept")
+			queries = append(queries, query)
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{"results":[{"extensions":[]}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewVSCodeChecker(srv.Client(), srv.URL, srv.URL)
+	c.Check(context.Background(), "myproject")
+
+	if accept != "application/json;api-version=3.0-preview.1" {
+		t.Errorf("unexpected Accept header %q", accept)
+	}
+	var searchText, publisher string
+	for _, q := range queries {
+		if v, ok := q.criterion(10); ok {
+			searchText = v
+		}
+		if v, ok := q.criterion(18); ok {
+			publisher = v
+		}
+	}
+	if searchText != "myproject" {
+		t.Errorf("expected search text 'myproject', got %+v", queries)
+	}
+	if publisher != "myproject" {
+		t.Errorf("expected publisher name 'myproject', got %+v", queries)
+	}
+}
+
+func TestVSCodeChecker_Name(t *testing.T) {
+	c := NewVSCodeChecker(http.DefaultClient, "", "")
+	if c.Name() != "vscode" {
+		t.Errorf("expected name 'vscode', got %q", c.Name())
+	}
+	if c.DisplayName() != "VS Code extensions" {
+		t.Errorf("expected display name 'VS Code extensions', got %q", c.DisplayName())
+	}
+}
@@ -215,18 +215,25 @@ func TestE2E_HomebrewChecker(t *testing.T) {
 func TestE2E_AllRegistriesOutput(t *testing.T) {
 	stdout, _, _ := runNsCheck("--no-color", "react")
 
-	// All 13 registries should appear in output (7 domain TLDs + 6 others)
+	// All 35 registries should appear in output (7 domain TLDs + 28 others)
 	for _, reg := range []string{
 		"Domain (.com)", "Domain (.io)", "Domain (.net)", "Domain (.app)",
 		"Domain (.ai)", "Domain (.sh)", "Domain (.tech)",
 		"npm", "crates.io", "GitHub", "GitHub Repo", "Docker Hub", "Homebrew",
+		"PyPI", "RubyGems", "Go modules", "Maven Central", "NuGet", "Packagist",
+		"Hex.pm", "pub.dev", "CocoaPods", "CPAN", "CRAN / Bioconductor", "Hackage",
+		"Linux distros", "AUR", "GitLab", "Bitbucket", "SourceHut",
+		"Hugging Face",
+		"VS Code extensions",
+		"Gitea (codeberg.org)",
+		"OCI (ghcr.io)", "OCI (quay.io)",
 	} {
 		if !strings.Contains(stdout, reg) {
 			t.Errorf("expected '%s' in output, got:\n%s", reg, stdout)
 		}
 	}
-	if !strings.Contains(stdout, "of 13 available") {
-		t.Errorf("expected 'of 13 available' in output, got:\n%s", stdout)
+	if !strings.Contains(stdout, "of 35 available") {
+		t.Errorf("expected 'of 35 available' in output, got:\n%s", stdout)
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -34,7 +35,7 @@ func run(args []string) int {
 		fmt.Fprintf(os.Stderr, "Usage: nsprobe [flags] <name>\n\n")
 		fmt.Fprintf(os.Stderr, "Flags:\n")
 		fs.PrintDefaults()
-		fmt.Fprintf(os.Stderr, "\nRegistries: domain (.com/.io/.net/.app/.ai/.sh/.tech), npm, github, github-repo, dockerhub, crates, homebrew\n")
+		fmt.Fprintf(os.Stderr, "\nRegistries: domain (.com/.io/.net/.app/.ai/.sh/.tech), npm, github, github-repo, dockerhub, crates, homebrew, pypi, rubygems, gomod, maven, nuget, packagist, hex, pubdev, cocoapods, cpan, r, hackage, distro, aur, gitlab, bitbucket, sourcehut, huggingface, vscode, gitea (codeberg.org), oci (ghcr.io/quay.io)\n")
 		fmt.Fprintf(os.Stderr, "\nExamples:\n")
 		fmt.Fprintf(os.Stderr, "  nsprobe myproject\n")
 		fmt.Fprintf(os.Stderr, "  nsprobe --only npm,github myproject\n")
@@ -86,7 +87,9 @@ func buildCheckers() []checker.Checker {
 	ghToken := os.Getenv("GITHUB_TOKEN")
 
 	domainTLDs := []string{"com", "io", "net", "app", "ai", "sh", "tech"}
-	checkers := make([]checker.Checker, 0, len(domainTLDs)+6)
+	forges := giteaInstances()
+	registries := ociRegistries()
+	checkers := make([]checker.Checker, 0, len(domainTLDs)+len(forges)+len(registries)+25)
 	for _, tld := range domainTLDs {
 		checkers = append(checkers, checker.NewDefaultDomainChecker(tld))
 	}
@@ -97,11 +100,97 @@ func buildCheckers() []checker.Checker {
 		checker.NewGitHubChecker(client, "https://api.github.com", ghToken),
 		checker.NewGitHubRepoChecker(client, "https://api.github.com", ghToken),
 		checker.NewDockerHubChecker(client, "https://hub.docker.com"),
-		checker.NewHomebrewChecker(client, "https://formulae.brew.sh"),
+		checker.NewHomebrewChecker(client, "https://formulae.brew.sh", "https://raw.githubusercontent.com/Homebrew/homebrew-core/HEAD", "https://api.github.com", ghToken),
+		checker.NewPyPIChecker(client, "https://pypi.org"),
+		checker.NewRubyGemsChecker(client, "https://rubygems.org"),
+		checker.NewGoModChecker(client, goProxyURL()),
+		checker.NewMavenChecker(client, "https://search.maven.org"),
+		checker.NewNuGetChecker(client, "https://api.nuget.org/v3/index.json"),
+		checker.NewPackagistChecker(client, "https://packagist.org"),
+		checker.NewHexChecker(client, "https://hex.pm"),
+		checker.NewPubDevChecker(client, "https://pub.dev"),
+		checker.NewCocoaPodsChecker(client, "https://trunk.cocoapods.org"),
+		checker.NewCPANChecker(client, "https://fastapi.metacpan.org"),
+		checker.NewRChecker(client, "https://crandb.r-pkg.org", "https://bioconductor.org"),
+		checker.NewHackageChecker(client, "https://hackage.haskell.org"),
+		checker.NewDistroChecker(client, "https://repology.org"),
+		checker.NewAURChecker(client, "https://aur.archlinux.org"),
+		checker.NewGitLabChecker(client, gitlabURL(), os.Getenv("GITLAB_TOKEN")),
+		checker.NewBitbucketChecker(client, "https://api.bitbucket.org"),
+		checker.NewSourceHutChecker(client, "https://git.sr.ht"),
+		checker.NewHuggingFaceChecker(client, "https://huggingface.co", os.Getenv("HF_TOKEN")),
+		checker.NewVSCodeChecker(client, "https://marketplace.visualstudio.com", "https://open-vsx.org"),
 	)
+	for _, forge := range forges {
+		checkers = append(checkers, checker.NewGiteaChecker(client, forge))
+	}
+	for _, registry := range registries {
+		checkers = append(checkers, checker.NewOCIChecker(client, registry))
+	}
 	return checkers
 }
 
+// ociRegistries returns the base URLs probed by the oci check: the hosts or
+// URLs listed in NSPROBE_OCI_REGISTRIES, or GHCR and Quay by default.
+func ociRegistries() []string {
+	return urlList(os.Getenv("NSPROBE_OCI_REGISTRIES"), "ghcr.io", "quay.io")
+}
+
+// giteaInstances returns the base URLs probed by the gitea check: Codeberg,
+// followed by any hosts or URLs listed in NSPROBE_GITEA_URLS.
+func giteaInstances() []string {
+	instances := []string{"https://codeberg.org"}
+	for _, u := range urlList(os.Getenv("NSPROBE_GITEA_URLS")) {
+		u = strings.TrimSuffix(u, "/")
+		if !slices.Contains(instances, u) {
+			instances = append(instances, u)
+		}
+	}
+	return instances
+}
+
+// gitlabURL returns the GitLab instance probed by the gitlab check:
+// NSPROBE_GITLAB_URL, or GitLab.com by default.
+func gitlabURL() string {
+	if urls := urlList(os.Getenv("NSPROBE_GITLAB_URL")); len(urls) > 0 {
+		return urls[0]
+	}
+	return "https://gitlab.com"
+}
+
+// urlList splits a comma-separated list of hosts or URLs, defaulting to the
+// given hosts when csv is empty. Entries without a scheme get https://.
+func urlList(csv string, defaults ...string) []string {
+	entries := defaults
+	if strings.TrimSpace(csv) != "" {
+		entries = strings.Split(csv, ",")
+	}
+	var out []string
+	for _, e := range entries {
+		e = strings.TrimSpace(e)
+		if e == "" {
+			continue
+		}
+		if !strings.HasPrefix(e, "https://") && !strings.HasPrefix(e, "http://") {
+			e = "https://" + e
+		}
+		out = append(out, e)
+	}
+	return out
+}
+
+// goProxyURL returns the first HTTP(S) proxy listed in GOPROXY, falling back
+// to the public proxy when GOPROXY is unset or only lists "direct"/"off".
+func goProxyURL() string {
+	for _, p := range strings.FieldsFunc(os.Getenv("GOPROXY"), func(r rune) bool { return r == ',' || r == '|' }) {
+		p = strings.TrimSpace(p)
+		if strings.HasPrefix(p, "https://") || strings.HasPrefix(p, "http://") {
+			return p
+		}
+	}
+	return "https://proxy.golang.org"
+}
+
 func filterCheckers(all []checker.Checker, only, skip string) []checker.Checker {
 	if only != "" {
 		set := toSet(only)
@@ -138,7 +227,7 @@ func exitCode(results []checker.Result) int {
 	hasError := false
 	for _, r := range results {
 		switch r.Status {
-		case checker.Taken:
+		case checker.Taken, checker.Reserved, checker.Invalid, checker.Adoptable:
 			return 1
 		case checker.Unknown:
 			hasError = true
@@ -52,6 +52,12 @@ func (p *Printer) Print(name string, results []checker.Result) {
 			_, _ = fmt.Fprintf(p.w, "  %s%s%s\n", r.Registry, padding, p.styled(green, "✓ available"))
 		case checker.Taken:
 			_, _ = fmt.Fprintf(p.w, "  %s%s%s\n", r.Registry, padding, p.styled(red, "✗ taken"))
+		case checker.Reserved:
+			_, _ = fmt.Fprintf(p.w, "  %s%s%s\n", r.Registry, padding, p.styled(red, "✗ reserved"))
+		case checker.Invalid:
+			_, _ = fmt.Fprintf(p.w, "  %s%s%s\n", r.Registry, padding, p.styled(red, "✗ invalid name"))
+		case checker.Adoptable:
+			_, _ = fmt.Fprintf(p.w, "  %s%s%s\n", r.Registry, padding, p.styled(yellow, "↻ adoptable"))
 		case checker.Unknown:
 			errMsg := "unknown error"
 			if r.Err != nil {
@@ -59,9 +65,19 @@ func (p *Printer) Print(name string, results []checker.Result) {
 			}
 			_, _ = fmt.Fprintf(p.w, "  %s%s%s\n", r.Registry, padding, p.styled(yellow, "⚠ "+errMsg))
 		}
+		detailPad := strings.Repeat(" ", maxLen+4)
 		if r.Detail != "" {
-			detailPad := strings.Repeat(" ", maxLen+4)
-			_, _ = fmt.Fprintf(p.w, "%s%s\n", detailPad, p.styled(dim, r.Detail))
+			// Checkers that report several findings put each on its own line.
+			for _, line := range strings.Split(r.Detail, "\n") {
+				_, _ = fmt.Fprintf(p.w, "%s%s\n", detailPad, p.styled(dim, line))
+			}
+		}
+		if len(r.Fields) > 0 {
+			parts := make([]string, len(r.Fields))
+			for i, f := range r.Fields {
+				parts[i] = f.Key + ": " + f.Value
+			}
+			_, _ = fmt.Fprintf(p.w, "%s%s\n", detailPad, p.styled(dim, strings.Join(parts, ", ")))
 		}
 	}
 
@@ -37,6 +37,54 @@ func TestPrinter_Taken(t *testing.T) {
 	}
 }
 
+func TestPrinter_Reserved(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewPrinterWithWriter(&buf, false)
+	results := []checker.Result{
+		{Registry: "NuGet", Name: "test", Status: checker.Reserved, Detail: "prefix Test.* is reserved"},
+	}
+	p.Print("test", results)
+
+	out := buf.String()
+	if !strings.Contains(out, "✗ reserved") {
+		t.Errorf("expected '✗ reserved' in output, got:\n%s", out)
+	}
+	if !strings.Contains(out, "0 of 1 available") {
+		t.Errorf("expected reserved not to count as available, got:\n%s", out)
+	}
+}
+
+func TestPrinter_Invalid(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewPrinterWithWriter(&buf, false)
+	results := []checker.Result{
+		{Registry: "pub.dev", Name: "my-tool", Status: checker.Invalid},
+	}
+	p.Print("my-tool", results)
+
+	out := buf.String()
+	if !strings.Contains(out, "✗ invalid name") {
+		t.Errorf("expected '✗ invalid name' in output, got:\n%s", out)
+	}
+}
+
+func TestPrinter_Adoptable(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewPrinterWithWriter(&buf, false)
+	results := []checker.Result{
+		{Registry: "AUR", Name: "test", Status: checker.Adoptable, Detail: "orphaned"},
+	}
+	p.Print("test", results)
+
+	out := buf.String()
+	if !strings.Contains(out, "↻ adoptable") {
+		t.Errorf("expected '↻ adoptable' in output, got:\n%s", out)
+	}
+	if !strings.Contains(out, "0 of 1 available") {
+		t.Errorf("expected adoptable not to count as available, got:\n%s", out)
+	}
+}
+
 func TestPrinter_Error(t *testing.T) {
 	var buf bytes.Buffer
 	p := NewPrinterWithWriter(&buf, false)
@@ -112,6 +160,41 @@ func TestPrinter_Detail(t *testing.T) {
 	}
 }
 
+func TestPrinter_MultiLineDetail(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewPrinterWithWriter(&buf, false)
+	results := []checker.Result{
+		{Registry: "npm", Name: "test", Status: checker.Available},
+		{Registry: "Maven Central", Name: "test", Status: checker.Taken, Detail: "groupId io.test\ngroupId com.test"},
+	}
+	p.Print("test", results)
+
+	pad := strings.Repeat(" ", len("Maven Central")+4)
+	out := buf.String()
+	for _, line := range []string{pad + "groupId io.test\n", pad + "groupId com.test\n"} {
+		if !strings.Contains(out, line) {
+			t.Errorf("expected indented detail line %q in output, got:\n%s", line, out)
+		}
+	}
+}
+
+func TestPrinter_Fields(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewPrinterWithWriter(&buf, false)
+	results := []checker.Result{
+		{Registry: "GitHub", Name: "test", Status: checker.Taken, Fields: []checker.Field{
+			{Key: "type", Value: "Organization"},
+			{Key: "created", Value: "2008-05-11"},
+		}},
+	}
+	p.Print("test", results)
+
+	want := strings.Repeat(" ", len("GitHub")+4) + "type: Organization, created: 2008-05-11\n"
+	if out := buf.String(); !strings.Contains(out, want) {
+		t.Errorf("expected fields line %q in output, got:\n%s", want, out)
+	}
+}
+
 func TestPrinter_NameInHeader(t *testing.T) {
 	var buf bytes.Buffer
 	p := NewPrinterWithWriter(&buf, false)
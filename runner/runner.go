@@ -7,6 +7,7 @@ import (
 )
 
 // Run executes all checkers concurrently and returns results in the same order as input.
+// Checkers implementing checker.NameTransformer are given their transformed name.
 func Run(ctx context.Context, checkers []checker.Checker, name string) []checker.Result {
 	if len(checkers) == 0 {
 		return nil
@@ -21,20 +22,26 @@ func Run(ctx context.Context, checkers []checker.Checker, name string) []checker
 
 	for i, c := range checkers {
 		go func(idx int, chk checker.Checker) {
+			n := name
 			defer func() {
 				if r := recover(); r != nil {
 					ch <- indexedResult{
 						index: idx,
 						result: checker.Result{
 							Registry: chk.DisplayName(),
-							Name:     name,
+							Name:     n,
 							Status:   checker.Unknown,
 							Err:      context.Canceled,
 						},
 					}
 				}
 			}()
-			ch <- indexedResult{index: idx, result: chk.Check(ctx, name)}
+			// Transform inside the recover so a panicking transform only
+			// affects its own checker.
+			if t, ok := chk.(checker.NameTransformer); ok {
+				n = t.TransformName(name)
+			}
+			ch <- indexedResult{index: idx, result: chk.Check(ctx, n)}
 		}(i, c)
 	}
 
@@ -33,6 +33,12 @@ func (m *mockChecker) Check(ctx context.Context, name string) checker.Result {
 	}
 }
 
+type transformingChecker struct {
+	mockChecker
+}
+
+func (m *transformingChecker) TransformName(name string) string { return "T-" + name }
+
 func TestRun_AllComplete(t *testing.T) {
 	checkers := []checker.Checker{
 		&mockChecker{
@@ -152,6 +158,47 @@ func TestRun_ConcurrentExecution(t *testing.T) {
 	}
 }
 
+func TestRun_NameTransformer(t *testing.T) {
+	checkers := []checker.Checker{
+		&mockChecker{name: "plain", displayName: "Plain", result: checker.Result{Registry: "Plain", Status: checker.Available}},
+		&transformingChecker{mockChecker{name: "cpan", displayName: "CPAN", result: checker.Result{Registry: "CPAN", Status: checker.Available}}},
+	}
+
+	results := Run(context.Background(), checkers, "my-tool")
+
+	if results[0].Name != "my-tool" {
+		t.Errorf("expected untransformed name 'my-tool', got %q", results[0].Name)
+	}
+	if results[1].Name != "T-my-tool" {
+		t.Errorf("expected transformed name 'T-my-tool', got %q", results[1].Name)
+	}
+}
+
+type panickingTransformer struct {
+	mockChecker
+}
+
+func (m *panickingTransformer) TransformName(name string) string { panic("bad name") }
+
+func TestRun_TransformPanicIsUnknown(t *testing.T) {
+	checkers := []checker.Checker{
+		&panickingTransformer{mockChecker{name: "bad", displayName: "Bad"}},
+		&mockChecker{name: "ok", displayName: "OK", result: checker.Result{Registry: "OK", Status: checker.Available}},
+	}
+
+	results := Run(context.Background(), checkers, "my-tool")
+
+	if results[0].Status != checker.Unknown {
+		t.Errorf("expected Unknown for the panicking transform, got %v", results[0].Status)
+	}
+	if results[0].Name != "my-tool" {
+		t.Errorf("expected untransformed name 'my-tool', got %q", results[0].Name)
+	}
+	if results[1].Status != checker.Available {
+		t.Errorf("expected the other checker to still run, got %v", results[1].Status)
+	}
+}
+
 func TestRun_EmptyCheckers(t *testing.T) {
 	results := Run(context.Background(), nil, "test")
 	if len(results) != 0 {